@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 
 	"git.bacardi55.io/bacardi55/walgot/internal/api"
 	"git.bacardi55.io/bacardi55/walgot/internal/config"
@@ -19,6 +20,7 @@ import (
 const currentVersion = "0.0.1"
 const defaultConfigJSON = "~/.config/walgot/walgot.json"
 const defaultCredentialsFile = "~/.config/walgot/credentials.json"
+const defaultViewsFile = "~/.config/walgot/views.json"
 const defaultLogFile = "/tmp/walgot.log"
 const defaultNbEntriesPerAPICall = 250
 
@@ -26,6 +28,8 @@ const defaultNbEntriesPerAPICall = 250
 type WalgotCmd struct {
 	config     config.WalgotConfig
 	teaProgram *tea.Program
+	// Subcommand and its arguments, starting the TUI if empty:
+	args []string
 }
 
 // New returns a WalgotCmd.
@@ -36,7 +40,7 @@ func New() *WalgotCmd {
 // Init initialize the application.
 func Init() (*WalgotCmd, error) {
 	// Manage command line flags:
-	configFile, debugMode := handleFlags()
+	configFile, debugMode, args := handleFlags()
 
 	// Check walgot configuration file path:
 	configFilePath, err := homedir.Expand(*configFile)
@@ -98,12 +102,48 @@ func Init() (*WalgotCmd, error) {
 		walgotConfig.NbEntriesPerAPICall = defaultNbEntriesPerAPICall
 	}
 
+	// Load views saved from walgot, after the configured ones:
+	if len(walgotConfig.ViewsFile) == 0 {
+		walgotConfig.ViewsFile = defaultViewsFile
+	}
+	viewsFilePath, err := homedir.Expand(walgotConfig.ViewsFile)
+	if err != nil {
+		if walgotConfig.DebugMode {
+			log.Println(err)
+		}
+		return &WalgotCmd{}, errors.New("couldn't determine path for views file")
+	}
+	walgotConfig.ViewsFile = viewsFilePath
+	savedViews, err := config.LoadViews(walgotConfig.ViewsFile)
+	if err != nil {
+		log.Println(err)
+		return &WalgotCmd{}, errors.New("couldn't load views file")
+	}
+	for _, view := range savedViews {
+		walgotConfig.Views = config.AddView(walgotConfig.Views, view)
+	}
+
 	// Initialize wallabago:
 	api.InitWallabagoAPI(walgotConfig.CredentialsFile)
 
+	// Subcommands don't need the TUI:
+	if len(args) > 0 {
+		return &WalgotCmd{
+			config: walgotConfig,
+			args:   args,
+		}, nil
+	}
+
+	// Create walgot model, checking keybinds, theme, columns and views configuration:
+	m, err := tui.NewModel(walgotConfig)
+	if err != nil {
+		log.Println(err)
+		return &WalgotCmd{}, errors.New("invalid configuration: " + err.Error())
+	}
+
 	// Create bubbletea program:
 	p := tea.NewProgram(
-		tui.NewModel(walgotConfig),
+		m,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
@@ -116,14 +156,22 @@ func Init() (*WalgotCmd, error) {
 
 // Run starts the application.
 func (cmd WalgotCmd) Run() {
+	if len(cmd.args) > 0 {
+		if err := runSubcommand(cmd.config, cmd.args); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := cmd.teaProgram.Start(); err != nil {
 		fmt.Println("Error running program:", err)
 		os.Exit(1)
 	}
 }
 
-// Manage debug flags.
-func handleFlags() (*string, *bool) {
+// Manage debug flags, returning the remaining arguments (subcommand).
+func handleFlags() (*string, *bool, []string) {
 	var (
 		version    = flag.Bool("version", false, "get walgot version")
 		debug      = flag.Bool("d", false, "enable debug output")
@@ -138,7 +186,36 @@ func handleFlags() (*string, *bool) {
 		fmt.Println("handleFlags: debug mode")
 	}
 
-	return configJSON, debug
+	return configJSON, debug, flag.Args()
+}
+
+// Run a subcommand:
+// - speak <entry id> <audio file>: write an entry read aloud to an audio file.
+func runSubcommand(walgotConfig config.WalgotConfig, args []string) error {
+	switch args[0] {
+	case "speak":
+		if len(args) != 3 {
+			return errors.New("usage: walgot speak <entry id> <audio file>")
+		}
+		entryID, err := strconv.Atoi(args[1])
+		if err != nil {
+			return errors.New("invalid entry id \"" + args[1] + "\"")
+		}
+		entry, err := api.GetEntry(entryID)
+		if err != nil {
+			log.Println(err)
+			return errors.New("couldn't retrieve entry " + args[1])
+		}
+		if err := tui.WriteSpeech(walgotConfig.SpeechFileCommand, &entry, args[2]); err != nil {
+			log.Println(err)
+			return err
+		}
+		fmt.Println("Entry", entryID, "written to", args[2])
+	default:
+		return errors.New("unknown command \"" + args[0] + "\"")
+	}
+
+	return nil
 }
 
 // Manage log configuration.
@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"net/url"
 	"strconv"
 
 	"github.com/Strubbl/wallabago/v7"
@@ -27,19 +28,23 @@ func GetEntries(itemsPerPage, pageNumber int, sortField, sortOrder string) (wall
 	)
 }
 
+// GetEntry returns an entry from wallabag APIs.
+func GetEntry(entryID int) (wallabago.Item, error) {
+	return wallabago.GetEntry(wallabago.APICall, entryID)
+}
+
 // GetNbTotalEntries returns the total number of entries saved in wallabag.
 func GetNbTotalEntries() (int, error) {
 	return wallabago.GetNumberOfTotalArticles()
 }
 
 // UpdateEntry update an article on wallabag.
-func UpdateEntry(entryID, archive, starred, public int) ([]byte, error) {
-	tmp := map[string]string{
-		"archive": strconv.Itoa(archive),
-		"starred": strconv.Itoa(starred),
-		"public":  strconv.Itoa(public),
+// Only the given fields are sent (eg: "archive", "title", "tags"…).
+func UpdateEntry(entryID int, fields map[string]string) ([]byte, error) {
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
 	}
-	body, _ := json.Marshal(tmp)
 	url := wallabago.Config.WallabagURL + "/api/entries/" + strconv.Itoa(entryID) + ".json"
 	// Send request and return result:
 	return wallabago.APICall(
@@ -49,11 +54,72 @@ func UpdateEntry(entryID, archive, starred, public int) ([]byte, error) {
 	)
 }
 
+// ReloadEntry asks wallabag to fetch again the content of an entry.
+func ReloadEntry(entryID int) (wallabago.Item, error) {
+	url := wallabago.Config.WallabagURL + "/api/entries/" + strconv.Itoa(entryID) + "/reload.json"
+	body, err := wallabago.APICall(url, "PATCH", nil)
+	if err != nil {
+		return wallabago.Item{}, err
+	}
+	// Wallabag answers with a 304 and no body if the content couldn't be fetched:
+	if len(body) == 0 {
+		return wallabago.Item{}, errors.New("wallabag couldn't reload entry " + strconv.Itoa(entryID))
+	}
+
+	var item wallabago.Item
+	if err := json.Unmarshal(body, &item); err != nil {
+		return wallabago.Item{}, err
+	}
+
+	return item, nil
+}
+
+// DeleteEntryTag removes a tag from an entry on wallabag.
+func DeleteEntryTag(entryID, tagID int) error {
+	return wallabago.DeleteEntryTag(entryID, tagID)
+}
+
+// NewEntry contains the data that can be sent when saving an entry.
+// Empty fields aren't sent, letting wallabag fetch them from the URL.
+type NewEntry struct {
+	URL            string
+	Title          string
+	Tags           string
+	Archive        int
+	Starred        int
+	Public         int
+	Content        string
+	Language       string
+	PreviewPicture string
+	PublishedAt    string
+	Authors        string
+	OriginURL      string
+}
+
 // AddEntry add an entry on wallabag.
-func AddEntry(url string) (wallabago.Item, error) {
+func AddEntry(entry NewEntry) (wallabago.Item, error) {
 	postData := map[string]string{
-		"url": url,
+		"url":     entry.URL,
+		"archive": strconv.Itoa(entry.Archive),
+		"starred": strconv.Itoa(entry.Starred),
+		"public":  strconv.Itoa(entry.Public),
+	}
+	optionalData := map[string]string{
+		"title":           entry.Title,
+		"tags":            entry.Tags,
+		"content":         entry.Content,
+		"language":        entry.Language,
+		"preview_picture": entry.PreviewPicture,
+		"published_at":    entry.PublishedAt,
+		"authors":         entry.Authors,
+		"origin_url":      entry.OriginURL,
+	}
+	for k, v := range optionalData {
+		if v != "" {
+			postData[k] = v
+		}
 	}
+
 	postDataJSON, err := json.Marshal(postData)
 	if err != nil {
 		return wallabago.Item{}, err
@@ -73,6 +139,37 @@ func AddEntry(url string) (wallabago.Item, error) {
 	return item, nil
 }
 
+// EntriesExist checks on wallabag if the given urls are already saved.
+// Returns a map with the URL as key and the entry ID as value (0 if not saved).
+func EntriesExist(urls []string) (map[string]int, error) {
+	existsURL := wallabago.Config.WallabagURL + "/api/entries/exists.json?return_id=1"
+	for _, u := range urls {
+		existsURL += "&urls[]=" + url.QueryEscape(u)
+	}
+
+	body, err := wallabago.APICall(existsURL, "GET", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// With return_id, wallabag returns the ID or null for each URL:
+	var response map[string]*int
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	exists := make(map[string]int, len(response))
+	for u, id := range response {
+		if id != nil {
+			exists[u] = *id
+		} else {
+			exists[u] = 0
+		}
+	}
+
+	return exists, nil
+}
+
 // DeleteEntry removes an entry from wallabag.
 func DeleteEntry(id int) error {
 	url := wallabago.Config.WallabagURL +
@@ -4,8 +4,63 @@ import (
 	"bytes"
 	"encoding/json"
 	"io/ioutil"
+	"os"
 )
 
+// WalgotTheme contains the colors used by walgot, as ANSI color numbers
+// (eg: "205") or hex codes (eg: "#874BFD"). Empty colors use the default theme ones.
+type WalgotTheme struct {
+	Accent      string
+	Border      string
+	TableBorder string
+	SelectedFg  string
+	SelectedBg  string
+	ButtonFg    string
+	ButtonBg    string
+}
+
+// WalgotColumn configures a column of the list view.
+// Width and Priority are optional, 0 means the column default.
+type WalgotColumn struct {
+	Name     string
+	Width    int
+	Priority int
+}
+
+// WalgotView is a saved view of the list, combining filters, sort and columns.
+// Sorting, Order and Columns are optional, empty means the default ones.
+type WalgotView struct {
+	Name     string
+	Unread   bool
+	Starred  bool
+	Archived bool
+	Public   bool
+	Failed   bool
+	Short    bool
+	Long     bool
+	Tags     []string
+	Domain   string
+	Search   string
+	Sorting  string
+	Order    string
+	Columns  []WalgotColumn
+}
+
+// WalgotReader configures how articles are displayed.
+// Zero values mean the default ones.
+type WalgotReader struct {
+	// Width of the text (in columns):
+	Width       int
+	MarginLeft  int
+	MarginRight int
+	// "center" or "left":
+	Align string
+	// Empty lines between lines of text:
+	LineSpacing int
+	// Break long words with a hyphen:
+	Hyphenation bool
+}
+
 // WalgotConfig contains all configuration data.
 type WalgotConfig struct {
 	CredentialsFile        string
@@ -17,6 +72,44 @@ type WalgotConfig struct {
 	NbEntriesPerAPICall    int
 	DefaultSorting         string
 	DefaultOrder           string
+	// Offer to save URLs copied while walgot is open:
+	ClipboardWatcher bool
+	// Keys per action name, replacing default keybinds:
+	Keybinds map[string][]string
+	// Theme name, built-in or defined in Themes:
+	Theme  string
+	Themes map[string]WalgotTheme
+	// Use ASCII characters instead of emoji for entries status:
+	ASCIIStatus bool
+	// List view columns, in display order:
+	Columns []WalgotColumn
+	// Date format of date columns, as a go layout:
+	DateFormat string
+	// Saved views, and the one displayed at startup:
+	Views       []WalgotView
+	DefaultView string
+	// File where views saved from walgot are stored:
+	ViewsFile string
+	// Display the list and the selected article side by side on wide terminals:
+	SplitView bool
+	// Inline images rendering ("none", "halfblocks" or "auto"):
+	InlineImages string
+	// Command opening images, given the image URL:
+	ImageViewer string
+	// Run the image viewer in the terminal, given to it until it exits:
+	ImageViewerTerminal bool
+	// Pre-fill tags of links saved from an article with "via: <article title>":
+	ViaTag bool
+	// Reading width, margins, alignment, line spacing and hyphenation:
+	Reader WalgotReader
+	// Archive the article read when going to the next one:
+	ArchiveOnNext bool
+	// Command reading text given on stdin aloud (eg: "espeak-ng", "say"):
+	SpeechCommand string
+	// Command the share message of an article (title and link) is piped to (eg: a mail or chat command):
+	ShareCommand string
+	// Command writing speech of text given on stdin to the file given as last argument, for "walgot speak":
+	SpeechFileCommand string
 }
 
 // LoadConfig will read a given configJSON file and parses the result, returning a parsed config object
@@ -47,3 +140,47 @@ func readJSON(raw []byte) (config WalgotConfig, err error) {
 	err = json.Unmarshal(raw, &config)
 	return
 }
+
+// LoadViews reads the views saved in the given file.
+// A missing file means no view has been saved yet.
+func LoadViews(viewsJSON string) ([]WalgotView, error) {
+	var views []WalgotView
+	raw, err := ioutil.ReadFile(viewsJSON)
+	if os.IsNotExist(err) {
+		return views, nil
+	} else if err != nil {
+		return views, err
+	}
+
+	raw = bytes.TrimPrefix(raw, []byte("\xef\xbb\xbf"))
+	err = json.Unmarshal(raw, &views)
+	return views, err
+}
+
+// SaveView adds a view to the given file, replacing any view with the same name.
+func SaveView(viewsJSON string, view WalgotView) error {
+	views, err := LoadViews(viewsJSON)
+	if err != nil {
+		return err
+	}
+
+	views = AddView(views, view)
+	raw, err := json.MarshalIndent(views, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(viewsJSON, raw, 0600)
+}
+
+// AddView adds a view to the list, replacing any view with the same name.
+func AddView(views []WalgotView, view WalgotView) []WalgotView {
+	for i := range views {
+		if views[i].Name == view.Name {
+			views[i] = view
+			return views
+		}
+	}
+
+	return append(views, view)
+}
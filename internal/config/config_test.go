@@ -15,10 +15,11 @@ func TestReadJson(t *testing.T) {
 		expectedDefaultListViewStarred bool
 		expectedNbEntriesPerAPICall    int
 		expectedDebugMode              bool
+		expectedClipboardWatcher       bool
 		expectedIsErrNil               bool
 	}{
 		{
-			"{\"CredentialsFile\": \"~/.config/walgot/credentials.json\", \"DefaultListViewUnread\": true, \"DefaultListViewStarred\": false, \"DebugMode\": true, \"LogFile\": \"/tmp/walgot.log\", \"NbEntriesPerAPICall\": 255, \"DefaultSorting\": \"created\", \"DefaultOrder\": \"desc\"}",
+			"{\"CredentialsFile\": \"~/.config/walgot/credentials.json\", \"DefaultListViewUnread\": true, \"DefaultListViewStarred\": false, \"DebugMode\": true, \"LogFile\": \"/tmp/walgot.log\", \"NbEntriesPerAPICall\": 255, \"DefaultSorting\": \"created\", \"DefaultOrder\": \"desc\", \"ClipboardWatcher\": true}",
 			"~/.config/walgot/credentials.json",
 			"/tmp/walgot.log",
 			"created",
@@ -28,6 +29,7 @@ func TestReadJson(t *testing.T) {
 			255,
 			true,
 			true,
+			true,
 		},
 		{
 			"{\"CredentialsFile\": \"~/.config/walgot/credentials.json\"}",
@@ -39,9 +41,10 @@ func TestReadJson(t *testing.T) {
 			false,
 			0,
 			false,
+			false,
 			true,
 		},
-		{"", "", "", "", "", false, false, 0, false, false},
+		{"", "", "", "", "", false, false, 0, false, false, false},
 	}
 	for _, test := range tests {
 		var raw = []byte(test.input)
@@ -70,9 +73,38 @@ func TestReadJson(t *testing.T) {
 		if c.DebugMode != test.expectedDebugMode {
 			t.Errorf("readJson(%v): expectedDebugMode %v, got %v", test.input, test.expectedDebugMode, c.DebugMode)
 		}
+		if c.ClipboardWatcher != test.expectedClipboardWatcher {
+			t.Errorf("readJson(%v): expectedClipboardWatcher %v, got %v", test.input, test.expectedClipboardWatcher, c.ClipboardWatcher)
+		}
 		isErrNil := (e == nil)
 		if isErrNil != test.expectedIsErrNil {
 			t.Errorf("readJson(%v): expectedIsErrNil %v, got %v", test.input, test.expectedIsErrNil, isErrNil)
 		}
 	}
 }
+
+func TestSaveView(t *testing.T) {
+	viewsFile := t.TempDir() + "/views.json"
+
+	views, err := LoadViews(viewsFile)
+	if err != nil || len(views) != 0 {
+		t.Errorf("LoadViews(): expected no view for missing file, got %v, %v", views, err)
+	}
+
+	if err := SaveView(viewsFile, WalgotView{Name: "coffee", Unread: true}); err != nil {
+		t.Errorf("SaveView(): expected no error, got %v", err)
+	}
+	if err := SaveView(viewsFile, WalgotView{Name: "go", Tags: []string{"go"}}); err != nil {
+		t.Errorf("SaveView(): expected no error, got %v", err)
+	}
+	if err := SaveView(viewsFile, WalgotView{Name: "coffee", Short: true}); err != nil {
+		t.Errorf("SaveView(): expected no error, got %v", err)
+	}
+
+	views, err = LoadViews(viewsFile)
+	if err != nil || len(views) != 2 {
+		t.Errorf("LoadViews(): expected 2 views, got %v, %v", views, err)
+	} else if views[0].Name != "coffee" || views[0].Unread || !views[0].Short || views[1].Tags[0] != "go" {
+		t.Errorf("LoadViews(): expected saved views, got %v", views)
+	}
+}
@@ -0,0 +1,203 @@
+package tui
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+// Default date format for date columns.
+const defaultDateFormat = "2006-01-02"
+
+// Minimum width of columns sharing the remaining space.
+const flexibleColumnMinWidth = 20
+
+// List column.
+type walgotColumn struct {
+	Name  string
+	Title string
+	// Width in cells, 0 for a column sharing the remaining space:
+	Width int
+	// On narrow terminals, columns with the highest priority number are removed first:
+	Priority int
+}
+
+// Available columns, with their default width and priority.
+var availableColumns = []walgotColumn{
+	{Name: "id", Title: "ID", Width: 6, Priority: 3},
+	{Name: "status", Title: "Status", Width: 6, Priority: 2},
+	{Name: "title", Title: "Title", Width: 0, Priority: 1},
+	{Name: "domain", Title: "Domain", Width: 20, Priority: 5},
+	{Name: "tags", Title: "Tags", Width: 20, Priority: 6},
+	{Name: "reading", Title: "Reading", Width: 8, Priority: 6},
+	{Name: "created", Title: "Created", Width: 10, Priority: 4},
+	{Name: "updated", Title: "Updated", Width: 10, Priority: 7},
+	{Name: "archived", Title: "Archived", Width: 10, Priority: 7},
+	{Name: "published", Title: "Published", Width: 10, Priority: 7},
+	{Name: "progress", Title: "Read", Width: 5, Priority: 7},
+	{Name: "language", Title: "Lang", Width: 5, Priority: 8},
+}
+
+// Columns displayed if none are configured.
+var defaultColumns = []string{"id", "status", "title", "domain", "created"}
+
+// Options needed to render row values.
+type walgotRowOptions struct {
+	Symbols    walgotStatusSymbols
+	DateFormat string
+	// Reading progress (in %) per entry ID:
+	Progress map[int]int
+}
+
+// Retrieve an available column by name.
+func getAvailableColumn(name string) (walgotColumn, bool) {
+	for _, c := range availableColumns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+
+	return walgotColumn{}, false
+}
+
+// Create list columns from configuration.
+// Width and priority not configured use the column default ones.
+func newColumns(configured []config.WalgotColumn) ([]walgotColumn, error) {
+	if len(configured) == 0 {
+		for _, name := range defaultColumns {
+			configured = append(configured, config.WalgotColumn{Name: name})
+		}
+	}
+
+	var columns []walgotColumn
+	for _, cc := range configured {
+		c, ok := getAvailableColumn(strings.ToLower(cc.Name))
+		if !ok {
+			defaults, _ := newColumns(nil)
+			return defaults, errors.New("unknown column \"" + cc.Name + "\"")
+		}
+		if cc.Width > 0 {
+			c.Width = cc.Width
+		}
+		if cc.Priority > 0 {
+			c.Priority = cc.Priority
+		}
+		columns = append(columns, c)
+	}
+
+	return columns, nil
+}
+
+// Retrieve the columns fitting in the given width, with their final width.
+// The ID column is always first, as it is needed to retrieve the selected entry,
+// but hidden (0 width) if not configured or if there isn't enough space.
+func getVisibleColumns(columns []walgotColumn, maxWidth int) []walgotColumn {
+	// Try to keep columns by priority:
+	byPriority := make([]int, len(columns))
+	for i := range byPriority {
+		byPriority[i] = i
+	}
+	sort.SliceStable(byPriority, func(i, j int) bool {
+		return columns[byPriority[i]].Priority < columns[byPriority[j]].Priority
+	})
+
+	// Cells have a padding of 1 on each side:
+	used := 2
+	kept := map[int]bool{}
+	for _, i := range byPriority {
+		width := columns[i].Width
+		if width == 0 {
+			width = flexibleColumnMinWidth
+		}
+		if columns[i].Name == "id" {
+			// Its padding is already counted:
+			width -= 2
+		}
+		// Keep at least one column, even on very narrow terminals:
+		if used+width+2 > maxWidth && len(kept) > 0 {
+			continue
+		}
+		kept[i] = true
+		used += width + 2
+	}
+
+	// Share remaining space between flexible columns:
+	nbFlexible := 0
+	for i := range kept {
+		if columns[i].Width == 0 {
+			nbFlexible++
+			used -= flexibleColumnMinWidth
+		}
+	}
+	flexibleWidth := 0
+	if nbFlexible > 0 {
+		flexibleWidth = (maxWidth - used) / nbFlexible
+		if flexibleWidth < 1 {
+			flexibleWidth = 1
+		}
+	}
+
+	visible := []walgotColumn{{Name: "id", Title: "ID", Width: 0}}
+	for i, c := range columns {
+		if !kept[i] {
+			continue
+		}
+		if c.Width == 0 {
+			c.Width = flexibleWidth
+		}
+		if c.Name == "id" {
+			visible[0] = c
+			continue
+		}
+		visible = append(visible, c)
+	}
+
+	return visible
+}
+
+// Retrieve the value of a column for an entry.
+func getColumnValue(name string, entry *wallabago.Item, options walgotRowOptions) string {
+	formatDate := func(t *wallabago.WallabagTime) string {
+		if t == nil || t.IsZero() {
+			return ""
+		}
+		return t.Format(options.DateFormat)
+	}
+
+	switch name {
+	case "id":
+		return strconv.Itoa(entry.ID)
+	case "status":
+		return getEntryStatus(entry, options.Symbols, true)
+	case "title":
+		return entry.Title
+	case "domain":
+		return entry.DomainName
+	case "tags":
+		return getEntryTagsText(entry)
+	case "reading":
+		return formatReadingTime(entry.ReadingTime)
+	case "created":
+		return formatDate(entry.CreatedAt)
+	case "updated":
+		return formatDate(entry.UpdatedAt)
+	case "archived":
+		return formatDate(entry.ArchivedAt)
+	case "published":
+		return formatDate(entry.PublishedAt)
+	case "progress":
+		if progress, ok := options.Progress[entry.ID]; ok {
+			return strconv.Itoa(progress) + "%"
+		}
+		return ""
+	case "language":
+		return entry.Language
+	}
+
+	return ""
+}
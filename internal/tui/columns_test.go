@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+func TestNewColumns(t *testing.T) {
+	var tests = []struct {
+		input            []config.WalgotColumn
+		expectedNames    []string
+		expectedWidths   []int
+		expectedIsErrNil bool
+	}{
+		{nil, []string{"id", "status", "title", "domain", "created"}, []int{6, 6, 0, 20, 10}, true},
+		{
+			[]config.WalgotColumn{{Name: "Title"}, {Name: "tags", Width: 30}, {Name: "reading"}},
+			[]string{"title", "tags", "reading"},
+			[]int{0, 30, 8},
+			true,
+		},
+		{
+			[]config.WalgotColumn{{Name: "title"}, {Name: "unknown"}},
+			[]string{"id", "status", "title", "domain", "created"},
+			[]int{6, 6, 0, 20, 10},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		columns, err := newColumns(test.input)
+		if (err == nil) != test.expectedIsErrNil {
+			t.Errorf("newColumns(%v): expected error nil %v, got %v", test.input, test.expectedIsErrNil, err)
+		}
+		if len(columns) != len(test.expectedNames) {
+			t.Errorf("newColumns(%v): expected %v columns, got %v", test.input, len(test.expectedNames), len(columns))
+			continue
+		}
+		for i, c := range columns {
+			if c.Name != test.expectedNames[i] || c.Width != test.expectedWidths[i] {
+				t.Errorf("newColumns(%v): expected column %v (%v), got %v (%v)", test.input, test.expectedNames[i], test.expectedWidths[i], c.Name, c.Width)
+			}
+		}
+	}
+}
+
+func TestGetVisibleColumns(t *testing.T) {
+	columns, _ := newColumns(nil)
+	noID, _ := newColumns([]config.WalgotColumn{{Name: "status"}, {Name: "title"}})
+
+	var tests = []struct {
+		columns        []walgotColumn
+		maxWidth       int
+		expectedNames  []string
+		expectedWidths []int
+	}{
+		{columns, 120, []string{"id", "status", "title", "domain", "created"}, []int{6, 6, 68, 20, 10}},
+		{columns, 60, []string{"id", "status", "title", "created"}, []int{6, 6, 30, 10}},
+		{columns, 30, []string{"id", "title"}, []int{6, 20}},
+		{columns, 26, []string{"id", "title"}, []int{0, 22}},
+		{noID, 80, []string{"id", "status", "title"}, []int{0, 6, 68}},
+	}
+
+	for _, test := range tests {
+		visible := getVisibleColumns(test.columns, test.maxWidth)
+		if len(visible) != len(test.expectedNames) {
+			t.Errorf("getVisibleColumns(%v): expected %v columns, got %v", test.maxWidth, test.expectedNames, visible)
+			continue
+		}
+		for i, c := range visible {
+			if c.Name != test.expectedNames[i] || c.Width != test.expectedWidths[i] {
+				t.Errorf("getVisibleColumns(%v): expected column %v (%v), got %v (%v)", test.maxWidth, test.expectedNames[i], test.expectedWidths[i], c.Name, c.Width)
+			}
+		}
+	}
+}
+
+func TestGetColumnValue(t *testing.T) {
+	created := wallabago.WallabagTime{Time: time.Date(2022, time.December, 5, 10, 0, 0, 0, time.UTC)}
+	entry := wallabago.Item{
+		ID:          42,
+		Title:       "Title",
+		DomainName:  "example.org",
+		ReadingTime: 7,
+		CreatedAt:   &created,
+		Tags:        []wallabago.Tag{{Label: "go"}, {Label: "tui"}},
+	}
+	options := walgotRowOptions{DateFormat: defaultDateFormat, Progress: map[int]int{42: 30}}
+
+	var tests = []struct {
+		name     string
+		expected string
+	}{
+		{"id", "42"},
+		{"title", "Title"},
+		{"domain", "example.org"},
+		{"tags", "go, tui"},
+		{"reading", "7 min"},
+		{"created", "2022-12-05"},
+		{"updated", ""},
+		{"progress", "30%"},
+		{"unknown", ""},
+	}
+
+	for _, test := range tests {
+		if output := getColumnValue(test.name, &entry, options); output != test.expected {
+			t.Errorf("getColumnValue(%v): expected %v, got %v", test.name, test.expected, output)
+		}
+	}
+
+	options.DateFormat = "02/01/2006"
+	if output := getColumnValue("created", &entry, options); output != "05/12/2022" {
+		t.Errorf("getColumnValue(created): expected 05/12/2022, got %v", output)
+	}
+}
@@ -0,0 +1,265 @@
+package tui
+
+import (
+	"image"
+	"regexp"
+	"strconv"
+	"strings"
+
+	md "github.com/JohannesKaufmann/html-to-markdown"
+	"github.com/JohannesKaufmann/html-to-markdown/escape"
+	"github.com/JohannesKaufmann/html-to-markdown/plugin"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/ansi"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+// Options of entries content rendering.
+type walgotContentOptions struct {
+	// Width of the viewport displaying the content:
+	Width  int
+	Reader walgotReader
+	// Glamour style, depending on the theme:
+	Style string
+	// Images downloaded for inline rendering, per URL:
+	Images map[string]image.Image
+	// Style of the highlighted paragraph and search match:
+	Highlight lipgloss.Style
+	// Paragraphs read aloud, and number of the one being read, highlighted (0 if none):
+	SpeechParagraphs []string
+	SpokenParagraph  int
+	// Searched term, with its matches highlighted, and number of the focused match (0 if none):
+	Search         string
+	SearchMatch    int
+	MatchHighlight lipgloss.Style
+}
+
+// Heading of an entry content.
+type walgotHeading struct {
+	Level int
+	Text  string
+	// Line of the heading in the rendered content:
+	Line int
+}
+
+// Link of an entry content.
+type walgotLink struct {
+	URL string
+	// Text of the link in the content, if any:
+	Text string
+}
+
+var (
+	// Links that can be opened, the same as plain text rendering:
+	contentLinkRE     = regexp.MustCompile(`(?i)^(https?|gopher|gemini)://`)
+	markdownEscapeRE  = regexp.MustCompile("\\\\([!-/:-@\\[-`{-~])")
+	contentSpacesRE   = regexp.MustCompile(`[ \t]+`)
+	ansiSequenceRE    = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+	markdownHeadingRE = regexp.MustCompile(`^#{1,6} `)
+)
+
+// Zero width mark of headings, repeated per level, to find them once rendered.
+const headingMark = "\u200b"
+
+// Retrieve the glamour style matching a theme.
+// Custom themes are based on the dark theme.
+func getContentStyle(theme walgotTheme) string {
+	switch {
+	case theme.NoColor:
+		return "notty"
+	case theme.Name == "light":
+		return "light"
+	}
+
+	return "dark"
+}
+
+// Convert an entry content to markdown, links being replaced by their number.
+func getMarkdownContentAndLinks(contentHTML string) (string, []walgotLink, error) {
+	var links []walgotLink
+
+	converter := md.NewConverter("", true, &md.Options{CodeBlockStyle: "fenced"})
+	converter.Use(plugin.GitHubFlavored())
+	converter.AddRules(md.Rule{
+		Filter:      []string{"#text"},
+		Replacement: escapeMarkdownText,
+	}, md.Rule{
+		Filter: []string{"a"},
+		Replacement: func(content string, selec *goquery.Selection, options *md.Options) *string {
+			href, _ := selec.Attr("href")
+			href = strings.TrimSpace(href)
+			if !contentLinkRE.MatchString(href) {
+				return md.String(content)
+			}
+			links = append(links, walgotLink{URL: href, Text: strings.Join(strings.Fields(selec.Text()), " ")})
+			return md.String(content + " [" + strconv.Itoa(len(links)) + "]")
+		},
+	}, md.Rule{
+		Filter:      []string{"h1", "h2", "h3", "h4", "h5", "h6"},
+		Replacement: headingMarkdown,
+	})
+
+	markdown, err := converter.ConvertString(contentHTML)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return markdown, links, nil
+}
+
+// Convert a text node to markdown, like the default rule.
+// Characters are escaped with HTML entities, as glamour displays backslash escapes.
+func escapeMarkdownText(content string, selec *goquery.Selection, options *md.Options) *string {
+	text := selec.Text()
+	if strings.TrimSpace(text) == "" {
+		return md.String("")
+	}
+	// Multiple spaces could make text an indented code block:
+	text = contentSpacesRE.ReplaceAllString(text, " ")
+	text = markdownEscapeRE.ReplaceAllStringFunc(escape.MarkdownCharacters(text), func(escaped string) string {
+		return "&#" + strconv.Itoa(int(escaped[1])) + ";"
+	})
+
+	// Spaces would break list items indentation:
+	parent, next := selec.Parent(), selec.Next()
+	if md.IndexWithText(selec) == 0 && (parent.Is("li") || parent.Is("ol") || parent.Is("ul")) && (next.Is("ul") || next.Is("ol")) {
+		text = strings.Trim(text, " ")
+	}
+
+	return &text
+}
+
+// Convert a heading to markdown, like the default rule without escaping "#" again.
+// Text is already escaped with HTML entities, that would be displayed as is.
+func headingMarkdown(content string, selec *goquery.Selection, options *md.Options) *string {
+	content = strings.TrimSpace(strings.NewReplacer("\n", " ", "\r", " ").Replace(content))
+	if content == "" {
+		return nil
+	}
+	if selec.ParentsFiltered("a").Length() > 0 {
+		return md.String(md.AddSpaceIfNessesary(selec, options.StrongDelimiter+content+options.StrongDelimiter))
+	}
+
+	level := int(goquery.NodeName(selec)[1] - '0')
+	return md.String("\n\n" + strings.Repeat("#", level) + " " + content + "\n\n")
+}
+
+// Render an entry content with styles, followed by links and images footnotes.
+// Links are returned numbered as in the rendered content.
+// Falls back to plain text if the content can't be rendered.
+func renderContent(contentHTML string, images []walgotImage, width int, style string) (string, []walgotLink) {
+	markdown, links, err := getMarkdownContentAndLinks(contentHTML)
+	var rendered string
+	if err == nil {
+		var renderer *glamour.TermRenderer
+		if renderer, err = glamour.NewTermRenderer(glamour.WithStyles(getContentStyleConfig(style)), glamour.WithWordWrap(width)); err == nil {
+			rendered, err = renderer.Render(markHeadings(markdown))
+		}
+	}
+	if err != nil {
+		content, urls := getContentForViewport(contentHTML, images)
+		content = strings.ReplaceAll(content, headingMark, "")
+		// Plain text rendering doesn't keep the links text:
+		links = make([]walgotLink, len(urls))
+		for i, u := range urls {
+			links[i] = walgotLink{URL: u}
+		}
+		return wrap.String(wordwrap.String(content, width), width), links
+	}
+
+	urls := make([]string, len(links))
+	for i, l := range links {
+		urls[i] = l.URL
+	}
+	footnotes := "\r\n" + generateFootnoteLinks(urls)
+	if len(images) > 0 {
+		footnotes += "\r\n" + generateFootnoteImages(images)
+	}
+	// URLs are never hyphenated:
+	footnotes = wrap.String(wordwrap.String(footnotes, width), width)
+
+	return rendered + footnotes, links
+}
+
+// Mark headings of markdown content, skipping code blocks.
+// Other lines can't start with "#", as it is escaped.
+// Marks already in the content (zero width spaces) are removed, not to be taken for headings.
+func markHeadings(markdown string) string {
+	lines := strings.Split(strings.ReplaceAll(markdown, headingMark, ""), "\n")
+	inCode := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimLeft(line, " "), "```") {
+			inCode = !inCode
+			continue
+		}
+		if position := markdownHeadingRE.FindStringIndex(line); !inCode && position != nil {
+			lines[i] = line[:position[1]] + strings.Repeat(headingMark, position[1]-1) + line[position[1]:]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Retrieve the headings of rendered content, from their marks.
+// Text of headings wrapped on several lines is joined.
+func getContentHeadings(content string) []walgotHeading {
+	lines := strings.Split(content, "\n")
+	var headings []walgotHeading
+	for i, line := range lines {
+		level := strings.Count(line, headingMark)
+		if level == 0 {
+			continue
+		}
+
+		text := strings.ReplaceAll(strings.TrimSpace(stripStyles(line)), headingMark, "")
+		text = strings.TrimSpace(strings.TrimLeft(text, "#"))
+		for j := i + 1; j < len(lines) && !isBlankLine(lines[j]); j++ {
+			text += " " + strings.TrimSpace(stripStyles(lines[j]))
+		}
+		headings = append(headings, walgotHeading{Level: level, Text: text, Line: i})
+	}
+
+	return headings
+}
+
+// Set the line of headings in laid out content, and remove their marks.
+func locateHeadings(content string, headings []walgotHeading) string {
+	lines := strings.Split(content, "\n")
+	index := 0
+	for i, line := range lines {
+		if !strings.Contains(line, headingMark) {
+			continue
+		}
+		lines[i] = strings.ReplaceAll(line, headingMark, "")
+		if index < len(headings) {
+			headings[index].Line = i
+			index++
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Retrieve the glamour style configuration, without margin as reader settings manage it.
+func getContentStyleConfig(style string) ansi.StyleConfig {
+	config := glamour.DarkStyleConfig
+	if styleConfig, ok := glamour.DefaultStyles[style]; ok {
+		config = *styleConfig
+	}
+	noMargin, margin := uint(0), uint(1)
+	config.Document.Margin = &noMargin
+	// Quotes token is 2 columns wide, when glamour counts 1 for the indentation.
+	// Margins are counted twice:
+	config.BlockQuote.Indent = &noMargin
+	config.BlockQuote.Margin = &margin
+
+	return config
+}
+
+// Remove styles of a rendered line.
+func stripStyles(line string) string {
+	return ansiSequenceRE.ReplaceAllString(line, "")
+}
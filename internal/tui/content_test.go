@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetContentStyle(t *testing.T) {
+	var tests = []struct {
+		theme    walgotTheme
+		expected string
+	}{
+		{walgotTheme{Name: "dark"}, "dark"},
+		{walgotTheme{Name: "light"}, "light"},
+		{walgotTheme{Name: "no-color", NoColor: true}, "notty"},
+		{walgotTheme{Name: "custom"}, "dark"},
+	}
+
+	for _, test := range tests {
+		if style := getContentStyle(test.theme); style != test.expected {
+			t.Errorf("getContentStyle(%v): expected %v, got %v", test.theme.Name, test.expected, style)
+		}
+	}
+}
+
+func TestGetMarkdownContentAndLinks(t *testing.T) {
+	contentHTML := `<h2>Title *1*</h2><p>A <a href="https://example.org">link</a>, a <a href="mailto:a@example.org">mail</a>` +
+		` and <a href="gemini://example.org/">another</a> with *stars* and [brackets].</p>` +
+		`<pre><code class="language-go">fmt.Println("*")</code></pre>`
+
+	markdown, links, err := getMarkdownContentAndLinks(contentHTML)
+	if err != nil {
+		t.Fatalf("getMarkdownContentAndLinks(): unexpected error %v", err)
+	}
+
+	expectedLinks := []walgotLink{
+		{URL: "https://example.org", Text: "link"},
+		{URL: "gemini://example.org/", Text: "another"},
+	}
+	if !reflect.DeepEqual(links, expectedLinks) {
+		t.Errorf("getMarkdownContentAndLinks(): expected links %v, got %v", expectedLinks, links)
+	}
+	for _, expected := range []string{
+		"## Title &#42;1&#42;\n",
+		"A link [1], a mail and another [2]",
+		"&#42;stars&#42; and &#91;brackets&#93;",
+		"```go\nfmt.Println(\"*\")",
+	} {
+		if !strings.Contains(markdown, expected) {
+			t.Errorf("getMarkdownContentAndLinks(): expected %q in %q", expected, markdown)
+		}
+	}
+
+	if _, links := renderContent(contentHTML, nil, 80, "notty"); !reflect.DeepEqual(links, expectedLinks) {
+		t.Errorf("renderContent(): expected links %v, got %v", expectedLinks, links)
+	}
+}
+
+func TestRenderContent(t *testing.T) {
+	contentHTML := `<p>Some *text* with a <a href="https://example.org">link</a>.</p><ul><li>First</li><li>Second</li></ul>`
+	images := []walgotImage{{URL: "https://example.org/cat.png", Alt: "A cat"}}
+
+	rendered, _ := renderContent(contentHTML, images, 40, "notty")
+	rendered = stripStyles(rendered)
+	for _, expected := range []string{
+		"Some *text* with a link [1].",
+		"• First",
+		"Links:",
+		"[1]: https://example.org",
+		"Images:",
+		"[1]: https://example.org/cat.png",
+	} {
+		if !strings.Contains(rendered, expected) {
+			t.Errorf("renderContent(): expected %q in %q", expected, rendered)
+		}
+	}
+	for _, line := range strings.Split(rendered, "\n") {
+		if len([]rune(strings.TrimRight(line, " \r"))) > 40 {
+			t.Errorf("renderContent(): expected lines of 40 characters at most, got %q", line)
+		}
+	}
+}
+
+func TestGetContentHeadings(t *testing.T) {
+	// Zero width spaces of the content aren't taken for heading marks:
+	rendered, _ := renderContent("<h1>Title</h1><p>Intro\u200b with a\u200b\u200b zero width space</p>"+`<h2>A long section title, wrapped</h2><pre><code># Not a heading</code></pre><h3>Sub *section*</h3>`, nil, 20, "notty")
+
+	headings := getContentHeadings(rendered)
+	expected := []walgotHeading{
+		{Level: 1, Text: "Title"},
+		{Level: 2, Text: "A long section title, wrapped"},
+		{Level: 3, Text: "Sub *section*"},
+	}
+	if len(headings) != len(expected) {
+		t.Fatalf("getContentHeadings(): expected %v, got %v", expected, headings)
+	}
+	for i, heading := range headings {
+		if heading.Level != expected[i].Level || heading.Text != expected[i].Text {
+			t.Errorf("getContentHeadings(): expected %v, got %v", expected[i], heading)
+		}
+	}
+}
+
+func TestLocateHeadings(t *testing.T) {
+	headings := []walgotHeading{{Level: 1, Text: "Title"}, {Level: 2, Text: "Section"}}
+	content := "# " + headingMark + "Title\n\nIntro\n\nText\n\n## " + strings.Repeat(headingMark, 2) + "Section"
+
+	expected := "# Title\n\nIntro\n\nText\n\n## Section"
+	if result := locateHeadings(content, headings); result != expected {
+		t.Errorf("locateHeadings(): expected %q, got %q", expected, result)
+	}
+	if headings[0].Line != 0 || headings[1].Line != 6 {
+		t.Errorf("locateHeadings(): expected headings on lines 0 and 6, got %v", headings)
+	}
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/Strubbl/wallabago/v7"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Formats of the entry read, when opened in an external program.
+const (
+	externalFormatText     = "text"
+	externalFormatMarkdown = "markdown"
+	externalFormatHTML     = "html"
+)
+
+// External programs, with their environment variable and default command.
+var externalPrograms = map[string][2]string{
+	"editor": {"EDITOR", "vi"},
+	"pager":  {"PAGER", "less"},
+}
+
+// External program closed message.
+type walgotExternalMsg struct {
+	Command string
+	err     error
+}
+
+// Retrieve the command of an external program ("editor" or "pager"), from the environment.
+func getExternalCommand(program string) string {
+	if command := strings.TrimSpace(os.Getenv(externalPrograms[program][0])); command != "" {
+		return command
+	}
+
+	return externalPrograms[program][1]
+}
+
+// Retrieve an entry content in a format, with its file extension.
+// The text format is the rendered content, without styles.
+func getExternalContent(entry *wallabago.Item, format, rendered string) (string, string, error) {
+	switch format {
+	case externalFormatText:
+		lines := strings.Split(strings.ReplaceAll(rendered, "\r", ""), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(stripStyles(line), " ")
+		}
+		return entry.Title + "\n" + entry.URL + "\n\n" + strings.Trim(strings.Join(lines, "\n"), "\n") + "\n", "txt", nil
+
+	case externalFormatMarkdown:
+		markdown, links, err := getMarkdownContentAndLinks(entry.Content)
+		if err != nil {
+			return "", "", err
+		}
+		content := "# " + entry.Title + "\n\n<" + entry.URL + ">\n\n" + strings.TrimSpace(markdown) + "\n"
+		if len(links) > 0 {
+			content += "\n"
+			for i, l := range links {
+				content += "[" + strconv.Itoa(i+1) + "]: " + l.URL + "\n"
+			}
+		}
+		return content, "md", nil
+
+	case externalFormatHTML:
+		return entry.Content, "html", nil
+	}
+
+	return "", "", errors.New("unknown format \"" + format + "\"")
+}
+
+// Command opening a content in an external program, through a temporary file removed afterward.
+// The program is given the terminal until it exits.
+func openExternalCommand(command, content, extension string) (tea.Cmd, error) {
+	file, err := os.CreateTemp("", "walgot-*."+extension)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.WriteString(content); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	args := strings.Fields(command)
+	c := exec.Command(args[0], append(args[1:], file.Name())...)
+
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		os.Remove(file.Name())
+		return walgotExternalMsg{Command: command, err: err}
+	}), nil
+}
+
+// Open the entry read in an external program ("editor" or "pager"), in a format.
+// The text format keeps the reader settings, without highlights nor inline images.
+func (m *model) openExternal(program, format string) (tea.Cmd, error) {
+	index := getSelectedEntryIndex(m.Entries, m.SelectedID)
+	if index < 0 {
+		return nil, errors.New("no article selected")
+	}
+
+	var rendered string
+	if format == externalFormatText {
+		options := m.getContentOptions()
+		options.Images = nil
+		options.SpokenParagraph = 0
+		options.Search = ""
+		options.Reader.MarginLeft = 0
+		rendered, _, _ = getSelectedEntryContent(m.Entries, index, options)
+	}
+
+	content, extension, err := getExternalContent(&m.Entries[index], format, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	return openExternalCommand(getExternalCommand(program), content, extension)
+}
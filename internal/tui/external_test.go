@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+func TestGetExternalContent(t *testing.T) {
+	entry := &wallabago.Item{
+		Title:   "Go release",
+		URL:     "https://example.org/go",
+		Content: `<p>Read the <a href="https://example.org/notes">notes</a>.</p>`,
+	}
+	rendered := "  \x1b[1mRead\x1b[0m the notes [1].   \r\n\r\n  Links:\r\n"
+
+	var tests = []struct {
+		format            string
+		expected          string
+		expectedExtension string
+	}{
+		{externalFormatText, "Go release\nhttps://example.org/go\n\n  Read the notes [1].\n\n  Links:\n", "txt"},
+		{externalFormatMarkdown, "# Go release\n\n<https://example.org/go>\n\nRead the notes [1].\n\n[1]: https://example.org/notes\n", "md"},
+		{externalFormatHTML, entry.Content, "html"},
+	}
+
+	for _, test := range tests {
+		content, extension, err := getExternalContent(entry, test.format, rendered)
+		if err != nil {
+			t.Errorf("getExternalContent(%q): unexpected error %v", test.format, err)
+		}
+		if content != test.expected || extension != test.expectedExtension {
+			t.Errorf("getExternalContent(%q): expected %q (%v), got %q (%v)", test.format, test.expected, test.expectedExtension, content, extension)
+		}
+	}
+
+	if _, _, err := getExternalContent(entry, "pdf", rendered); err == nil {
+		t.Errorf("getExternalContent(\"pdf\"): expected an error")
+	}
+}
+
+func TestGetExternalCommand(t *testing.T) {
+	t.Setenv("PAGER", "")
+	if command := getExternalCommand("pager"); command != "less" {
+		t.Errorf("getExternalCommand(\"pager\"): expected \"less\", got %q", command)
+	}
+
+	t.Setenv("EDITOR", "nvim -R")
+	if command := getExternalCommand("editor"); command != "nvim -R" {
+		t.Errorf("getExternalCommand(\"editor\"): expected \"nvim -R\", got %q", command)
+	}
+}
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+// Fuzzy matching scores, inspired by fzf:
+// matched characters score, and consecutive ones or ones starting a word score more,
+// while gaps between matched characters reduce the score.
+const (
+	fuzzyScoreMatch       = 16
+	fuzzyBonusConsecutive = 8
+	fuzzyBonusBoundary    = 8
+	fuzzyPenaltyGapStart  = 3
+	fuzzyPenaltyGap       = 1
+)
+
+// Result of the fuzzy finder.
+type walgotFuzzyResult struct {
+	// Index of the entry in the model entries:
+	Index int
+	Score int
+	// Matched characters (rune index) of the title, domain and tags:
+	TitlePositions  []int
+	DomainPositions []int
+	TagsPositions   []int
+}
+
+// Fuzzy match a pattern in a text (case insensitive).
+// Returns the score and the position (rune index) of matched characters.
+func fuzzyMatch(pattern, text string) (int, []int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	original := []rune(text)
+	t := []rune(strings.ToLower(text))
+	if len(p) == 0 {
+		return 0, nil, true
+	}
+	if len(t) != len(original) {
+		// Lowercase changed the number of runes, compare on the original text:
+		t = original
+	}
+
+	// Find the end of the first match:
+	pi, end := 0, -1
+	for i := 0; i < len(t) && pi < len(p); i++ {
+		if t[i] == p[pi] {
+			pi++
+			if pi == len(p) {
+				end = i
+			}
+		}
+	}
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	// Go back to find the shortest match ending there:
+	pi, start := len(p)-1, 0
+	for i := end; i >= 0; i-- {
+		if t[i] == p[pi] {
+			pi--
+			if pi < 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	// Score matched characters:
+	var positions []int
+	score, pi, consecutive := 0, 0, false
+	for i := start; i <= end && pi < len(p); i++ {
+		if t[i] != p[pi] {
+			if consecutive {
+				score -= fuzzyPenaltyGapStart
+			} else {
+				score -= fuzzyPenaltyGap
+			}
+			consecutive = false
+			continue
+		}
+
+		positions = append(positions, i)
+		score += fuzzyScoreMatch
+		if consecutive {
+			score += fuzzyBonusConsecutive
+		}
+		if i == 0 || (!unicode.IsLetter(original[i-1]) && !unicode.IsDigit(original[i-1])) {
+			score += fuzzyBonusBoundary
+		}
+		consecutive = true
+		pi++
+	}
+
+	return score, positions, true
+}
+
+// Fuzzy find entries matching the query in their title, domain or tags,
+// best matches first. Space separated terms all need to match.
+func fuzzyFindEntries(entries []wallabago.Item, query string) []walgotFuzzyResult {
+	terms := strings.Fields(query)
+	var results []walgotFuzzyResult
+
+	for i := 0; i < len(entries); i++ {
+		result := walgotFuzzyResult{Index: i}
+		tags := getEntryTagsText(&entries[i])
+		matched := true
+
+		for _, term := range terms {
+			titleScore, titlePositions, titleOk := fuzzyMatch(term, entries[i].Title)
+			domainScore, domainPositions, domainOk := fuzzyMatch(term, entries[i].DomainName)
+			tagsScore, tagsPositions, tagsOk := fuzzyMatch(term, tags)
+
+			// Keep the best matching field:
+			if titleOk && (!domainOk || titleScore >= domainScore) && (!tagsOk || titleScore >= tagsScore) {
+				result.Score += titleScore
+				result.TitlePositions = append(result.TitlePositions, titlePositions...)
+			} else if domainOk && (!tagsOk || domainScore >= tagsScore) {
+				result.Score += domainScore
+				result.DomainPositions = append(result.DomainPositions, domainPositions...)
+			} else if tagsOk {
+				result.Score += tagsScore
+				result.TagsPositions = append(result.TagsPositions, tagsPositions...)
+			} else {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results
+}
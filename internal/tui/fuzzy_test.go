@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Strubbl/wallabago/v7"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	var tests = []struct {
+		pattern           string
+		text              string
+		expectedPositions []int
+		expectedOk        bool
+	}{
+		{"", "Walgot", nil, true},
+		{"wgt", "Walgot", []int{0, 3, 5}, true},
+		{"WAL", "walgot", []int{0, 1, 2}, true},
+		{"got", "Walgot got", []int{3, 4, 5}, true},
+		{"tow", "Walgot", nil, false},
+		{"été", "Un été", []int{3, 4, 5}, true},
+	}
+
+	for _, test := range tests {
+		_, positions, ok := fuzzyMatch(test.pattern, test.text)
+		if ok != test.expectedOk || fmt.Sprint(positions) != fmt.Sprint(test.expectedPositions) {
+			t.Errorf("fuzzyMatch(%v, %v): expected %v %v, got %v %v", test.pattern, test.text, test.expectedOk, test.expectedPositions, ok, positions)
+		}
+	}
+
+	// Consecutive and word start matches rank first:
+	consecutive, _, _ := fuzzyMatch("go", "golang")
+	spread, _, _ := fuzzyMatch("go", "big robot")
+	wordStart, _, _ := fuzzyMatch("go", "Go lang")
+	middle, _, _ := fuzzyMatch("go", "ago")
+	if consecutive <= spread {
+		t.Errorf("fuzzyMatch(): expected consecutive score %v > spread score %v", consecutive, spread)
+	}
+	if wordStart <= middle {
+		t.Errorf("fuzzyMatch(): expected word start score %v > middle score %v", wordStart, middle)
+	}
+}
+
+func TestFuzzyFindEntries(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, Title: "Rust ownership", DomainName: "rust-lang.org"},
+		{ID: 2, Title: "Hello Go", DomainName: "go.dev", Tags: []wallabago.Tag{{Label: "golang"}}},
+		{ID: 3, Title: "Something else", DomainName: "example.org"},
+	}
+
+	var tests = []struct {
+		query       string
+		expectedIDs []int
+	}{
+		{"", []int{1, 2, 3}},
+		{"go", []int{2, 1}},
+		{"golang", []int{2}},
+		{"rust own", []int{1}},
+		{"example", []int{3}},
+		{"nothing", []int{}},
+	}
+
+	for _, test := range tests {
+		ids := []int{}
+		for _, r := range fuzzyFindEntries(items, test.query) {
+			ids = append(ids, items[r.Index].ID)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(test.expectedIDs) {
+			t.Errorf("fuzzyFindEntries(%v): expected %v, got %v", test.query, test.expectedIDs, ids)
+		}
+	}
+
+	results := fuzzyFindEntries(items, "lang")
+	if len(results) != 2 || len(results[0].TitlePositions) != 0 {
+		t.Errorf("fuzzyFindEntries(lang): expected domain or tags matches only, got %v", results)
+	}
+}
+
+func TestHighlightPositions(t *testing.T) {
+	// Styles are only rendered with a color profile:
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	style := lipgloss.NewStyle().Bold(true)
+	expected := "\x1b[1mW\x1b[0mal\x1b[1mg\x1b[0mot"
+	if output := highlightPositions("Walgot", []int{0, 3}, style); output != expected {
+		t.Errorf("highlightPositions(): expected %q, got %q", expected, output)
+	}
+	if output := highlightPositions("Walgot", nil, style); output != "Walgot" {
+		t.Errorf("highlightPositions(): expected Walgot, got %q", output)
+	}
+}
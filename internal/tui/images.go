@@ -0,0 +1,366 @@
+package tui
+
+import (
+	"errors"
+	"html"
+	"image"
+	"image/color"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	// Image formats that can be rendered inline:
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"github.com/Strubbl/wallabago/v7"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/k3a/html2text"
+	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/termenv"
+)
+
+// Inline images rendering modes.
+const (
+	inlineImagesNone       = "none"
+	inlineImagesHalfBlocks = "halfblocks"
+	// Half blocks if the terminal supports colors:
+	inlineImagesAuto = "auto"
+)
+
+const (
+	// Maximum size of downloaded images (in bytes):
+	imageMaxSize = 10 << 20
+	imageTimeout = time.Second * 10
+	// Images are kept downscaled to the maximum content width (in pixels):
+	imageMaxWidth = 80
+	// Maximum height of inline images (in lines):
+	imageMaxLines = 20
+	// Alternative text longer than this is truncated in placeholders:
+	imageMaxAltLength = 60
+)
+
+// Image of an entry content.
+type walgotImage struct {
+	URL string
+	Alt string
+}
+
+var (
+	imageTagRE         = regexp.MustCompile(`(?is)<img\b[^>]*>`)
+	imageAttrRE        = regexp.MustCompile(`(?is)\b(src|alt)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+	imagePlaceholderRE = regexp.MustCompile(`^\[Image (\d+)[\]:]`)
+)
+
+// Check if images are rendered inline, depending on the configured mode.
+// Images are only rendered with half blocks, sixel and kitty graphics aren't supported.
+func newInlineImages(mode string) (bool, error) {
+	switch mode {
+	case "", inlineImagesNone:
+		return false, nil
+	case inlineImagesHalfBlocks:
+		return true, nil
+	case inlineImagesAuto:
+		return lipgloss.ColorProfile() != termenv.Ascii, nil
+	case "sixel", "kitty":
+		return false, errors.New("inline images mode \"" + mode + "\" isn't supported, use \"" + inlineImagesHalfBlocks + "\"")
+	}
+
+	return false, errors.New("unknown inline images mode \"" + mode + "\"")
+}
+
+// Replace images of the entry content by numbered placeholders, and retrieve them.
+// Relative image URLs are resolved from the entry URL.
+func getEntryContentAndImages(entry *wallabago.Item) (string, []walgotImage) {
+	var images []walgotImage
+
+	content := imageTagRE.ReplaceAllStringFunc(entry.Content, func(tag string) string {
+		var src, alt string
+		for _, attr := range imageAttrRE.FindAllStringSubmatch(tag, -1) {
+			value := html2text.HTMLEntitiesToText(attr[2] + attr[3] + attr[4])
+			if strings.EqualFold(attr[1], "src") {
+				src = strings.TrimSpace(value)
+			} else {
+				alt = strings.TrimSpace(value)
+			}
+		}
+		// Embedded images can't be opened:
+		if src == "" || strings.HasPrefix(src, "data:") {
+			return ""
+		}
+
+		images = append(images, walgotImage{URL: resolveImageURL(entry.URL, src), Alt: alt})
+		return "<br>" + html.EscapeString(getImagePlaceholder(len(images), alt)) + "<br>"
+	})
+
+	return content, images
+}
+
+// Resolve an image URL relative to the entry URL.
+func resolveImageURL(base, src string) string {
+	u, err := url.Parse(src)
+	if err != nil || u.IsAbs() {
+		return src
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return src
+	}
+
+	return b.ResolveReference(u).String()
+}
+
+// Placeholder of an image in content, eg: "[Image 2: A cat]".
+func getImagePlaceholder(number int, alt string) string {
+	if alt == "" {
+		return "[Image " + strconv.Itoa(number) + "]"
+	}
+
+	return "[Image " + strconv.Itoa(number) + ": " + truncate.StringWithTail(alt, imageMaxAltLength, "…") + "]"
+}
+
+// Generate footnote text for images in article.
+func generateFootnoteImages(images []walgotImage) string {
+	footnotes := "Images:\r\n\r\n"
+	for i, img := range images {
+		footnotes += "[" + strconv.Itoa(i+1) + "]: " + img.URL + "\r\n"
+	}
+
+	return footnotes
+}
+
+// Insert downloaded images above their placeholder in rendered content,
+// with the same indentation.
+func insertInlineImages(content string, images []walgotImage, downloaded map[string]image.Image, maxWidth int) string {
+	if len(downloaded) == 0 || len(images) == 0 {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	result := make([]string, 0, len(lines))
+	inserted := map[int]bool{}
+	for _, line := range lines {
+		text := strings.TrimRight(stripStyles(line), " \r")
+		trimmed := strings.TrimLeft(text, " ")
+		match := imagePlaceholderRE.FindStringSubmatch(trimmed)
+		if match != nil {
+			number, _ := strconv.Atoi(match[1])
+			if number >= 1 && number <= len(images) && !inserted[number] {
+				inserted[number] = true
+				if img := downloaded[images[number-1].URL]; img != nil {
+					indent := len(text) - len(trimmed)
+					for _, imageLine := range strings.Split(renderHalfBlocks(img, maxWidth-indent), "\n") {
+						result = append(result, strings.Repeat(" ", indent)+imageLine)
+					}
+				}
+			}
+		}
+		result = append(result, line)
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// Render an image with half block characters, each line displaying two rows of pixels.
+func renderHalfBlocks(img image.Image, maxWidth int) string {
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return ""
+	}
+
+	width := b.Dx()
+	if width > maxWidth {
+		width = maxWidth
+	}
+	height := b.Dy() * width / b.Dx()
+	if height > imageMaxLines*2 {
+		height = imageMaxLines * 2
+		width = b.Dx() * height / b.Dy()
+	}
+	if width == 0 || height == 0 {
+		return ""
+	}
+
+	resized := resizeImage(img, width, height)
+	profile := lipgloss.ColorProfile()
+	var lines []string
+	for y := 0; y < height; y += 2 {
+		var line strings.Builder
+		for x := 0; x < width; x++ {
+			s := profile.String("▀").Foreground(profile.FromColor(resized.At(x, y)))
+			if y+1 < height {
+				s = s.Background(profile.FromColor(resized.At(x, y+1)))
+			}
+			line.WriteString(s.String())
+		}
+		lines = append(lines, line.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Resize an image, averaging the pixels of each area.
+func resizeImage(img image.Image, width, height int) *image.RGBA64 {
+	b := img.Bounds()
+	resized := image.NewRGBA64(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0, y1 := b.Min.Y+y*b.Dy()/height, b.Min.Y+(y+1)*b.Dy()/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0, x1 := b.Min.X+x*b.Dx()/width, b.Min.X+(x+1)*b.Dx()/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			resized.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(bl / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return resized
+}
+
+// Download an image, downscaled for inline rendering.
+func fetchImage(u string) (image.Image, error) {
+	client := http.Client{Timeout: imageTimeout}
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("unexpected status " + resp.Status)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, imageMaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if b := img.Bounds(); b.Dx() > imageMaxWidth {
+		height := b.Dy() * imageMaxWidth / b.Dx()
+		if height == 0 {
+			height = 1
+		}
+		img = resizeImage(img, imageMaxWidth, height)
+	}
+
+	return img, nil
+}
+
+// Images downloaded for inline rendering message.
+// Images that couldn't be downloaded are nil.
+type walgotImagesMsg struct {
+	EntryID int
+	Images  map[string]image.Image
+}
+
+// Image viewer closed message.
+type walgotImageViewerMsg struct {
+	err error
+}
+
+// Command downloading images of an entry.
+func fetchImagesCommand(entryID int, urls []string) tea.Cmd {
+	return func() tea.Msg {
+		images := map[string]image.Image{}
+		for _, u := range urls {
+			// Errors are ignored, the placeholder is enough:
+			images[u], _ = fetchImage(u)
+		}
+
+		return walgotImagesMsg{EntryID: entryID, Images: images}
+	}
+}
+
+// Command opening an image with the configured viewer.
+// Terminal viewers are given the terminal until they exit, other viewers are started in the background.
+func openImageCommand(viewer, u string, terminal bool) tea.Cmd {
+	args := strings.Fields(viewer)
+	if len(args) == 0 {
+		return func() tea.Msg {
+			return walgotImageViewerMsg{err: errors.New("no image viewer command")}
+		}
+	}
+	c := exec.Command(args[0], append(args[1:], u)...)
+
+	if !terminal {
+		return func() tea.Msg {
+			return walgotImageViewerMsg{err: c.Start()}
+		}
+	}
+
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return walgotImageViewerMsg{err: err}
+	})
+}
+
+// Open an image of the entry read with the configured viewer.
+// Without viewer, images are opened like links.
+func (m *model) openImage(u string) tea.Cmd {
+	if m.ImageViewer != "" {
+		return openImageCommand(m.ImageViewer, u, m.ImageViewerTerminal)
+	}
+
+	if err := openLinkInBrowser(u); err != nil {
+		m.Dialog.Message = "Couldn't open image"
+		if m.DebugMode {
+			log.Println("Error while opening image")
+			log.Println(err)
+		}
+		return nil
+	}
+	m.UpdateMessage = "Image opened"
+
+	return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+		return wallabagoResponseClearMsg(true)
+	})
+}
+
+// Retrieve the command downloading the entry images not downloaded yet,
+// if images are rendered inline.
+func (m *model) getImagesCommand(entryID int) tea.Cmd {
+	index := getSelectedEntryIndex(m.Entries, entryID)
+	if !m.InlineImages || index < 0 {
+		return nil
+	}
+
+	_, images := getEntryContentAndImages(&m.Entries[index])
+	var urls []string
+	for _, img := range images {
+		if _, ok := m.Images[img.URL]; ok {
+			continue
+		}
+		// Being downloaded:
+		m.Images[img.URL] = nil
+		urls = append(urls, img.URL)
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	return fetchImagesCommand(entryID, urls)
+}
@@ -0,0 +1,153 @@
+package tui
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Strubbl/wallabago/v7"
+	"github.com/muesli/reflow/ansi"
+)
+
+func TestNewInlineImages(t *testing.T) {
+	var tests = []struct {
+		mode        string
+		expected    bool
+		expectedErr bool
+	}{
+		{"", false, false},
+		{"none", false, false},
+		{"halfblocks", true, false},
+		{"sixel", false, true},
+		{"kitty", false, true},
+		{"unknown", false, true},
+	}
+
+	for _, test := range tests {
+		inline, err := newInlineImages(test.mode)
+		if inline != test.expected || (err != nil) != test.expectedErr {
+			t.Errorf("newInlineImages(%q): expected %v (error: %v), got %v (%v)", test.mode, test.expected, test.expectedErr, inline, err)
+		}
+	}
+}
+
+func TestOpenImageCommand(t *testing.T) {
+	msg := openImageCommand("  ", "https://example.org/cat.png", true)()
+	if v, ok := msg.(walgotImageViewerMsg); !ok || v.err == nil {
+		t.Errorf("openImageCommand: expected an error without viewer command, got %v", msg)
+	}
+
+	// Other viewers than terminal ones are started without waiting for them:
+	if msg := openImageCommand("true", "https://example.org/cat.png", false)(); msg != (walgotImageViewerMsg{}) {
+		t.Errorf("openImageCommand(true): expected the viewer to start, got %v", msg)
+	}
+	msg = openImageCommand("walgot-unknown-viewer", "https://example.org/cat.png", false)()
+	if v, ok := msg.(walgotImageViewerMsg); !ok || v.err == nil {
+		t.Errorf("openImageCommand(walgot-unknown-viewer): expected an error, got %v", msg)
+	}
+}
+
+func TestGetEntryContentAndImages(t *testing.T) {
+	entry := wallabago.Item{
+		URL: "https://example.org/blog/post.html",
+		Content: `<p>Intro</p><img src="https://cdn.example.org/cat.png" alt="A &quot;cat&quot;">` +
+			`<p><IMG SRC='/images/dog.jpg'></p>` +
+			`<img src="data:image/png;base64,AAAA" alt="Embedded">` +
+			`<img alt="No source">`,
+	}
+
+	content, images := getEntryContentAndImages(&entry)
+	expected := []walgotImage{
+		{URL: "https://cdn.example.org/cat.png", Alt: `A "cat"`},
+		{URL: "https://example.org/images/dog.jpg", Alt: ""},
+	}
+	if !reflect.DeepEqual(images, expected) {
+		t.Errorf("getEntryContentAndImages(): expected images %v, got %v", expected, images)
+	}
+
+	text, _ := getCleanedContentAndLinks(content)
+	for _, placeholder := range []string{`[Image 1: A "cat"]`, "[Image 2]"} {
+		if !strings.Contains(text, placeholder) {
+			t.Errorf("getEntryContentAndImages(): expected placeholder %q in %q", placeholder, text)
+		}
+	}
+	if strings.Contains(text, "[Image 3") {
+		t.Errorf("getEntryContentAndImages(): images without source shouldn't have a placeholder, got %q", text)
+	}
+}
+
+func TestNewImageList(t *testing.T) {
+	images := []walgotImage{
+		{URL: "https://example.org/cat.png", Alt: "A cat"},
+		{URL: "https://example.org/images/dog.jpg"},
+	}
+	list := newImageList(images)
+	if len(list.Results) != 2 {
+		t.Errorf("newImageList(): expected all images as results, got %v", list.Results)
+	}
+
+	// Images are filtered by alternative text or URL:
+	for query, expected := range map[string]int{"cat": 0, "dog": 1} {
+		if results := fuzzyFindLinks(list.Links, query); len(results) != 1 || results[0].Index != expected {
+			t.Errorf("fuzzyFindLinks(%q): expected image %v, got %v", query, expected+1, results)
+		}
+	}
+}
+
+func TestInsertInlineImages(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 4))
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 4; y++ {
+			img.Set(x, y, color.White)
+		}
+	}
+	images := []walgotImage{
+		{URL: "https://example.org/cat.png", Alt: "A cat"},
+		{URL: "https://example.org/dog.png"},
+	}
+	content := "Intro\n [Image 1: A cat]\nText\n[Image 2]\n\nImages:\n\n[1]: https://example.org/cat.png"
+
+	// Only downloaded images are inserted, above their placeholder:
+	result := insertInlineImages(content, images, map[string]image.Image{images[0].URL: img, images[1].URL: nil}, 72)
+	lines := strings.Split(result, "\n")
+	if len(lines) != len(strings.Split(content, "\n"))+2 {
+		t.Fatalf("insertInlineImages(): expected 2 image lines, got %q", result)
+	}
+	// Images have the indentation of their placeholder:
+	for _, line := range lines[1:3] {
+		if !strings.HasPrefix(line, " ▀") || ansi.PrintableRuneWidth(line) != 9 {
+			t.Errorf("insertInlineImages(): expected an indented 8 columns wide image line, got %q", line)
+		}
+	}
+	if strings.TrimSpace(lines[3]) != "[Image 1: A cat]" {
+		t.Errorf("insertInlineImages(): expected the placeholder below the image, got %q", lines[3])
+	}
+
+	if result := insertInlineImages(content, images, map[string]image.Image{}, 72); result != content {
+		t.Errorf("insertInlineImages(): expected unchanged content without images, got %q", result)
+	}
+}
+
+func TestRenderHalfBlocks(t *testing.T) {
+	var tests = []struct {
+		width, height   int
+		maxWidth        int
+		expectedColumns int
+		expectedLines   int
+	}{
+		{8, 4, 72, 8, 2},
+		{80, 40, 40, 40, 10},
+		// Height is limited, keeping the ratio:
+		{40, 200, 72, 8, imageMaxLines},
+	}
+
+	for _, test := range tests {
+		rendered := renderHalfBlocks(image.NewRGBA(image.Rect(0, 0, test.width, test.height)), test.maxWidth)
+		lines := strings.Split(rendered, "\n")
+		if len(lines) != test.expectedLines || ansi.PrintableRuneWidth(lines[0]) != test.expectedColumns {
+			t.Errorf("renderHalfBlocks(%vx%v, %v): expected %v columns and %v lines, got %v and %v", test.width, test.height, test.maxWidth, test.expectedColumns, test.expectedLines, ansi.PrintableRuneWidth(lines[0]), len(lines))
+		}
+	}
+}
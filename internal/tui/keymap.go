@@ -0,0 +1,375 @@
+package tui
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Views keybinds can be attached to, in help display order.
+// Global keybinds are available on all views.
+var keyMapViews = []struct {
+	Name  string
+	Title string
+}{
+	{"global", "On all screens"},
+	{"list", "On listing page"},
+	{"detail", "On detail page"},
+	{"domains", "On domains page"},
+	{"views", "On views page"},
+	{"finder", "On fuzzy finder"},
+	{"palette", "On command palette"},
+	{"links", "On links list"},
+	{"images", "On images list"},
+	{"toc", "On table of contents"},
+	{"share", "On share menu"},
+	{"dialog", "On any dialog (modal) or form view"},
+	{"help", "On help page"},
+}
+
+// Views where keys are typed in text inputs.
+var textInputKeyMapViews = []string{"finder", "palette", "links", "images", "dialog"}
+
+// A keybind attached to an action on a view.
+type walgotKeyBinding struct {
+	View    string
+	Action  string
+	Binding key.Binding
+}
+
+// Active keybinds.
+type walgotKeyMap struct {
+	Bindings []walgotKeyBinding
+}
+
+// Create a keybind.
+func newKeyBinding(view, action, help string, keys ...string) walgotKeyBinding {
+	return walgotKeyBinding{
+		View:   view,
+		Action: action,
+		Binding: key.NewBinding(
+			key.WithKeys(keys...),
+			key.WithHelp(strings.Join(keys, " / "), help),
+		),
+	}
+}
+
+// Default keybinds.
+func defaultKeyBindings() []walgotKeyBinding {
+	return []walgotKeyBinding{
+		newKeyBinding("global", "forceQuit", "Quit", "ctrl+c"),
+		newKeyBinding("global", "help", "Help (this page)", "?"),
+
+		newKeyBinding("list", "reload", "Reload article from wallabag via APIs, takes time depending on the number of articles saved", "r"),
+		newKeyBinding("list", "filterUnread", "Toggle display only unread articles (disable archived filter)", "u"),
+		newKeyBinding("list", "filterStarred", "Toggle display only starred articles", "s"),
+		newKeyBinding("list", "filterArchived", "Toggle archived only articles (disable unread filter)", "a"),
+		newKeyBinding("list", "filterPublic", "Toggle public only articles (articles with a public link)", "p"),
+		newKeyBinding("list", "filterFailed", "Toggle articles wallabag couldn't retrieve the content for", "f"),
+		newKeyBinding("list", "filterShort", "Toggle articles with a reading time under 5 minutes (disable long filter)", "<"),
+		newKeyBinding("list", "filterLong", "Toggle articles with a reading time over 20 minutes (disable short filter)", ">"),
+		newKeyBinding("list", "filterDomain", "Toggle display only articles from the selected article domain", "d"),
+		newKeyBinding("list", "domains", "Display domains with their number of unread and total articles", "w"),
+		newKeyBinding("list", "palette", "Open the command palette, to run any action or command", ":"),
+		newKeyBinding("list", "finder", "Fuzzy find an article by title, domain or tags, and read it", "ctrl+t"),
+		newKeyBinding("list", "views", "Display saved views (filters, sort and columns)", "v"),
+		newKeyBinding("list", "saveView", "Save current filters, sort and columns as a view", "V"),
+		newKeyBinding("list", "selectView", "Display the saved view with this number", "1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		newKeyBinding("list", "toggleArchive", "Toggle Archive / Unread for the current article (and update wallabag backend)", "A"),
+		newKeyBinding("list", "toggleStarred", "Toggle Starred / Unstarred for the current article (and update wallabag backend)", "S"),
+		newKeyBinding("list", "togglePublic", "Toggle Public status - Public means article can be shared with a public link", "P"),
+		newKeyBinding("list", "open", "Open article public link url in default browser. If article isn't public, it will open the original article link.", "O"),
+		newKeyBinding("list", "yank", "Yank (copy) URL to clipboard. If article isn't public, it will open the original article link.", "Y"),
+		newKeyBinding("list", "share", "Share the article: copy as Markdown or org-mode link, citation or public link, or send it with the share command", "ctrl+y"),
+		newKeyBinding("list", "search", "Open search box", "/"),
+		newKeyBinding("list", "add", "Add a new url to wallabag (with optional title, tags, status and content). URL is pre-filled from the clipboard.", "N"),
+		newKeyBinding("list", "delete", "Delete the selected entry.", "D"),
+		newKeyBinding("list", "reloadEntry", "Reload the selected entry content from its source", "R"),
+		newKeyBinding("list", "reloadFailed", "Reload the content of all entries wallabag couldn't retrieve", "F"),
+		newKeyBinding("list", "clearSearch", "Clean search filter, if any", "esc"),
+		newKeyBinding("list", "up", "Move up one item in the list", "k", "up"),
+		newKeyBinding("list", "down", "Move down one item in the list", "j", "down"),
+		newKeyBinding("list", "pageUp", "Move up 10 items in the list", "pgup"),
+		newKeyBinding("list", "pageDown", "Move down 10 items in the list", "pgdown"),
+		newKeyBinding("list", "top", "Go to the top of the list", "home"),
+		newKeyBinding("list", "bottom", "Go to bottom of the list", "end"),
+		newKeyBinding("list", "select", "Select entry to read content", "enter"),
+		newKeyBinding("list", "toggleSplit", "Toggle split view, the list on the left and the selected article on the right (wide terminals only)", "|"),
+		newKeyBinding("list", "switchPane", "In split view, move focus to the article pane", "tab"),
+		newKeyBinding("list", "quit", "Remove search filter if any, otherwise quit", "q"),
+
+		newKeyBinding("detail", "toggleArchive", "Toggle Archive / Unread for the current article (and update wallabag backend)", "A"),
+		newKeyBinding("detail", "toggleStarred", "Toggle Starred / Unstarred for the current article (and update wallabag backend)", "S"),
+		newKeyBinding("detail", "togglePublic", "Toggle Public status - Public means article can be shared with a public link", "P"),
+		newKeyBinding("detail", "open", "Open article public link url in default browser. If article isn't public, it will open the original article link.", "O"),
+		newKeyBinding("detail", "yank", "Yank (copy) URL to clipboard. If article isn't public, it will open the original article link.", "Y"),
+		newKeyBinding("detail", "share", "Share the article: copy as Markdown or org-mode link, citation or public link, or send it with the share command", "ctrl+y"),
+		newKeyBinding("detail", "links", "List links within content with their text, to filter them (by text, URL or number) and open, copy or save one", "L"),
+		newKeyBinding("detail", "nextLink", "Focus the next link within content (the first visible one if none is focused)", "tab"),
+		newKeyBinding("detail", "previousLink", "Focus the previous link within content", "shift+tab"),
+		newKeyBinding("detail", "openLink", "Open the focused link in default browser", "enter"),
+		newKeyBinding("detail", "yankLink", "Yank (copy) the focused link to clipboard", "y"),
+		newKeyBinding("detail", "saveLink", "Save the focused link to wallabag, with optional tags", "a"),
+		newKeyBinding("detail", "unfocusLink", "Remove the focus from the focused link, or clear the search within content", "esc"),
+		newKeyBinding("detail", "search", "Search within content, highlighting all matches", "/"),
+		newKeyBinding("detail", "nextMatch", "Go to the next match of the search", "n"),
+		newKeyBinding("detail", "previousMatch", "Go to the previous match of the search", "N"),
+		newKeyBinding("detail", "toc", "Display the table of contents of the article, to go to a section", "t"),
+		newKeyBinding("detail", "speak", "Read the article aloud with the speech command, from the first visible paragraph, or pause / resume reading", "v"),
+		newKeyBinding("detail", "speechNext", "While reading aloud, skip to the next paragraph", ">"),
+		newKeyBinding("detail", "speechPrevious", "While reading aloud, go back to the previous paragraph", "<"),
+		newKeyBinding("detail", "speechStop", "Stop reading aloud", "V"),
+		newKeyBinding("detail", "pager", "Open the article as text in $PAGER (default less)", "p"),
+		newKeyBinding("detail", "editor", "Open the article as text in $EDITOR (default vi), eg: to copy quotes", "e"),
+		newKeyBinding("detail", "images", "List images within content, to open them with the configured image viewer", "I"),
+		newKeyBinding("detail", "reloadEntry", "Reload the entry content from its source", "R"),
+		newKeyBinding("detail", "edit", "Edit entry metadata (title, tags, language, original URL, published date and authors)", "E"),
+		newKeyBinding("detail", "delete", "Delete the selected entry.", "D"),
+		newKeyBinding("detail", "palette", "Open the command palette, to run any action or command", ":"),
+		newKeyBinding("detail", "back", "Return to list", "q"),
+		newKeyBinding("detail", "nextEntry", "Read the next article of the list, archiving the current one if \"ArchiveOnNext\" is set", "]"),
+		newKeyBinding("detail", "previousEntry", "Read the previous article of the list", "["),
+		newKeyBinding("detail", "toggleSplit", "Toggle split view, the list on the left and the article on the right (wide terminals only)", "|"),
+		newKeyBinding("detail", "switchPane", "In split view, move focus back to the list", "ctrl+w"),
+		newKeyBinding("detail", "widerText", "Widen the text of articles", "+"),
+		newKeyBinding("detail", "narrowerText", "Narrow the text of articles", "-"),
+		newKeyBinding("detail", "toggleAlign", "Toggle centered / left aligned text of articles", "c"),
+		newKeyBinding("detail", "toggleSpacing", "Change the line spacing of articles (none, 1 or 2 empty lines)", "="),
+		newKeyBinding("detail", "toggleHyphenation", "Toggle hyphenation of long words in articles", "H"),
+		newKeyBinding("detail", "up", "Go up", "k", "up"),
+		newKeyBinding("detail", "down", "Go down", "j", "down"),
+		newKeyBinding("detail", "pageUp", "Go up half a page", "pgup"),
+		newKeyBinding("detail", "pageDown", "Go down half a page", "pgdown"),
+		newKeyBinding("detail", "top", "Go to the top of the article", "home"),
+		newKeyBinding("detail", "bottom", "Go to the bottom of the article", "end"),
+
+		newKeyBinding("domains", "select", "Display only articles from the selected domain", "enter"),
+		newKeyBinding("domains", "back", "Return to list", "q", "esc"),
+		newKeyBinding("domains", "up", "Move up one domain in the list", "k", "up"),
+		newKeyBinding("domains", "down", "Move down one domain in the list", "j", "down"),
+		newKeyBinding("domains", "pageUp", "Move up 10 domains in the list", "pgup"),
+		newKeyBinding("domains", "pageDown", "Move down 10 domains in the list", "pgdown"),
+		newKeyBinding("domains", "top", "Go to the top of the list", "home"),
+		newKeyBinding("domains", "bottom", "Go to bottom of the list", "end"),
+
+		newKeyBinding("views", "select", "Display the selected view", "enter"),
+		newKeyBinding("views", "back", "Return to list", "q", "esc"),
+		newKeyBinding("views", "up", "Move up one view in the list", "k", "up"),
+		newKeyBinding("views", "down", "Move down one view in the list", "j", "down"),
+		newKeyBinding("views", "top", "Go to the top of the list", "home"),
+		newKeyBinding("views", "bottom", "Go to bottom of the list", "end"),
+
+		newKeyBinding("finder", "select", "Read the selected article", "enter"),
+		newKeyBinding("finder", "close", "Close the fuzzy finder", "esc"),
+		newKeyBinding("finder", "up", "Move up one result", "ctrl+p", "up"),
+		newKeyBinding("finder", "down", "Move down one result", "ctrl+n", "down"),
+
+		newKeyBinding("palette", "select", "Run the command, or the selected one", "enter"),
+		newKeyBinding("palette", "close", "Close the command palette", "esc"),
+		newKeyBinding("palette", "complete", "Complete with the selected command", "tab"),
+		newKeyBinding("palette", "up", "Move up one command", "up"),
+		newKeyBinding("palette", "down", "Move down one command", "down"),
+		newKeyBinding("palette", "historyPrevious", "Previous command in history", "ctrl+p"),
+		newKeyBinding("palette", "historyNext", "Next command in history", "ctrl+n"),
+
+		newKeyBinding("links", "select", "Open the selected link in default browser", "enter"),
+		newKeyBinding("links", "focus", "Focus the selected link in the article", "tab"),
+		newKeyBinding("links", "copy", "Copy the selected link to clipboard", "ctrl+y"),
+		newKeyBinding("links", "save", "Save the selected link to wallabag, with optional tags", "ctrl+s"),
+		newKeyBinding("links", "close", "Close the links list", "esc"),
+		newKeyBinding("links", "up", "Move up one link", "ctrl+p", "up"),
+		newKeyBinding("links", "down", "Move down one link", "ctrl+n", "down"),
+
+		newKeyBinding("images", "select", "Open the selected image with the image viewer", "enter"),
+		newKeyBinding("images", "copy", "Copy the selected image link to clipboard", "ctrl+y"),
+		newKeyBinding("images", "close", "Close the images list", "esc"),
+		newKeyBinding("images", "up", "Move up one image", "ctrl+p", "up"),
+		newKeyBinding("images", "down", "Move down one image", "ctrl+n", "down"),
+
+		newKeyBinding("toc", "select", "Go to the selected section", "enter"),
+		newKeyBinding("toc", "close", "Close the table of contents", "q", "esc"),
+		newKeyBinding("toc", "up", "Move up one section", "k", "up"),
+		newKeyBinding("toc", "down", "Move down one section", "j", "down"),
+		newKeyBinding("toc", "top", "Go to the first section", "home"),
+		newKeyBinding("toc", "bottom", "Go to the last section", "end"),
+
+		newKeyBinding("share", "select", "Share the article with the selected option", "enter"),
+		newKeyBinding("share", "close", "Close the share menu", "q", "esc"),
+		newKeyBinding("share", "up", "Move up one option", "k", "up"),
+		newKeyBinding("share", "down", "Move down one option", "j", "down"),
+
+		newKeyBinding("dialog", "close", "Close the dialog or form", "esc"),
+		newKeyBinding("dialog", "confirm", "Confirm the dialog (search, save link…) or save the form", "enter"),
+		newKeyBinding("dialog", "nextField", "Go to next form field (completes tags when a known tag matches)", "tab"),
+		newKeyBinding("dialog", "previousField", "Go to previous form field", "shift+tab"),
+		newKeyBinding("dialog", "up", "Go to previous field of the edit form", "up"),
+		newKeyBinding("dialog", "down", "Go to next field of the edit form", "down"),
+		newKeyBinding("dialog", "toggle", "Toggle form checkboxes", " "),
+		newKeyBinding("dialog", "submit", "Save the form from any field (eg: from add form content)", "ctrl+s"),
+
+		newKeyBinding("help", "back", "Return to list", "q", "esc"),
+	}
+}
+
+// Create the keymap from default keybinds and the ones configured by the user.
+// Configured keybinds are given per action name and replace the default keys
+// of this action on all views, or per "view.action" to only change one view.
+// Actions given by name aren't changed on text input views if they exist on other views,
+// eg: "up" keys of the list aren't used in the fuzzy finder.
+func newKeyMap(custom map[string][]string) (walgotKeyMap, error) {
+	bindings := defaultKeyBindings()
+
+	for name, keys := range custom {
+		view, action := "", name
+		if i := strings.Index(name, "."); i >= 0 {
+			view, action = name[:i], name[i+1:]
+		}
+		skipTextInput := false
+		for _, b := range bindings {
+			if view == "" && b.Action == action && !containsString(textInputKeyMapViews, b.View) {
+				skipTextInput = true
+				break
+			}
+		}
+
+		found := false
+		for i := range bindings {
+			if bindings[i].Action != action || (view != "" && bindings[i].View != view) ||
+				(skipTextInput && containsString(textInputKeyMapViews, bindings[i].View)) {
+				continue
+			}
+			found = true
+			if len(keys) == 0 {
+				bindings[i].Binding.SetEnabled(false)
+				continue
+			}
+			bindings[i].Binding.SetKeys(keys...)
+			bindings[i].Binding.SetHelp(strings.Join(keys, " / "), bindings[i].Binding.Help().Desc)
+		}
+		if !found {
+			return walgotKeyMap{Bindings: defaultKeyBindings()}, errors.New("unknown keybind action \"" + name + "\"")
+		}
+	}
+
+	keyMap := walgotKeyMap{Bindings: bindings}
+	if err := keyMap.validate(); err != nil {
+		return walgotKeyMap{Bindings: defaultKeyBindings()}, err
+	}
+
+	return keyMap, nil
+}
+
+// Check that a key isn't used by two actions on the same view,
+// and that keys of text input views can't be typed.
+func (k walgotKeyMap) validate() error {
+	var conflicts []string
+	for _, b := range k.Bindings {
+		if !containsString(textInputKeyMapViews, b.View) || !b.Binding.Enabled() {
+			continue
+		}
+		for _, keyName := range b.Binding.Keys() {
+			if isTypedKey(keyName) {
+				conflicts = append(conflicts, "\""+keyName+"\" of "+b.View+"."+b.Action+" would be typed in text inputs")
+			}
+		}
+	}
+	for _, view := range keyMapViews {
+		used := map[string]string{}
+		for _, b := range k.Bindings {
+			if (b.View != view.Name && b.View != "global") || !b.Binding.Enabled() {
+				continue
+			}
+			for _, keyName := range b.Binding.Keys() {
+				actionName := b.View + "." + b.Action
+				if previous, ok := used[keyName]; ok && previous != actionName {
+					conflicts = append(conflicts, "\""+keyName+"\" is used by both "+previous+" and "+actionName)
+				}
+				used[keyName] = actionName
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return errors.New("keybind conflicts: " + strings.Join(conflicts, ", "))
+	}
+
+	return nil
+}
+
+// Check if a key is typed in text inputs, eg: "k".
+// Space is allowed, it toggles form checkboxes that aren't typed in.
+func isTypedKey(keyName string) bool {
+	runes := []rune(keyName)
+
+	return len(runes) == 1 && keyName != " " && unicode.IsPrint(runes[0])
+}
+
+// Retrieve the action matching the key message on the given view.
+// Returns an empty string if no action matches.
+func (k walgotKeyMap) action(view string, msg tea.KeyMsg) string {
+	for _, b := range k.Bindings {
+		if b.View == view && key.Matches(msg, b.Binding) {
+			return b.Action
+		}
+	}
+
+	return ""
+}
+
+// Retrieve the position of the pressed key in the keys of an action,
+// eg: to select an item by number. Returns -1 if the key isn't used by the action.
+func (k walgotKeyMap) keyIndex(view, action string, msg tea.KeyMsg) int {
+	for _, b := range k.Bindings {
+		if b.View != view || b.Action != action {
+			continue
+		}
+		for i, keyName := range b.Binding.Keys() {
+			if keyName == msg.String() {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// Retrieve the action of a key message, or of an action run from the palette, on the given view.
+// Also returns the position of the key in the keys of the action (see keyIndex).
+func (k walgotKeyMap) messageAction(view string, msg tea.Msg) (string, int) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		action := k.action(view, msg)
+		return action, k.keyIndex(view, action, msg)
+	case walgotActionMsg:
+		return msg.Action, msg.Index
+	}
+
+	return "", -1
+}
+
+// Check if an action exists on the given view, even if its keys are disabled.
+func (k walgotKeyMap) hasAction(view, action string) bool {
+	for _, b := range k.Bindings {
+		if b.View == view && b.Action == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Retrieve the keys used for an action, as displayed in help.
+func (k walgotKeyMap) helpKey(view, action string) string {
+	for _, b := range k.Bindings {
+		if b.View == view && b.Action == action {
+			return b.Binding.Help().Key
+		}
+	}
+
+	return ""
+}
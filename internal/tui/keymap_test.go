@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNewKeyMap(t *testing.T) {
+	var tests = []struct {
+		input            map[string][]string
+		expectedIsErrNil bool
+	}{
+		{nil, true},
+		{map[string][]string{"toggleArchive": {"x"}}, true},
+		{map[string][]string{"search": {"ctrl+f", "/"}}, true},
+		{map[string][]string{"links": {}}, true},
+		{map[string][]string{"unknownAction": {"x"}}, false},
+		// Already used by filterUnread on list view:
+		{map[string][]string{"toggleArchive": {"u"}}, false},
+		// Already used by global help:
+		{map[string][]string{"edit": {"?"}}, false},
+		// Same key on different views is fine:
+		{map[string][]string{"back": {"x"}, "delete": {"x"}}, false},
+		{map[string][]string{"links": {"x"}, "reload": {"x"}}, true},
+		// Actions given by name aren't changed on text input views:
+		{map[string][]string{"up": {"k", "up"}, "close": {"q", "esc"}}, true},
+		{map[string][]string{"historyPrevious": {"ctrl+k"}}, true},
+		{map[string][]string{"finder.up": {"ctrl+k", "up"}}, true},
+		{map[string][]string{"list.unknownAction": {"x"}}, false},
+		// Keys typed in text inputs:
+		{map[string][]string{"finder.up": {"k"}}, false},
+		{map[string][]string{"historyPrevious": {"p"}}, false},
+	}
+
+	for _, test := range tests {
+		_, e := newKeyMap(test.input)
+		isErrNil := (e == nil)
+		if isErrNil != test.expectedIsErrNil {
+			t.Errorf("newKeyMap(%v): expectedIsErrNil %v, got %v (%v)", test.input, test.expectedIsErrNil, isErrNil, e)
+		}
+	}
+
+	// "k" is still typed in the fuzzy finder:
+	keyMap, _ := newKeyMap(map[string][]string{"up": {"k", "up"}})
+	k := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")}
+	if keyMap.action("list", k) != "up" || keyMap.action("finder", k) != "" {
+		t.Errorf("newKeyMap(up): expected \"k\" to only be used on the list, got %q on finder", keyMap.action("finder", k))
+	}
+}
+
+func TestKeyMapAction(t *testing.T) {
+	keyMap, _ := newKeyMap(map[string][]string{"toggleArchive": {"x"}, "links": {}})
+
+	var tests = []struct {
+		inputView      string
+		inputKey       tea.KeyMsg
+		expectedAction string
+	}{
+		{"list", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}, "toggleArchive"},
+		{"detail", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}, "toggleArchive"},
+		{"list", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")}, ""},
+		{"list", tea.KeyMsg{Type: tea.KeyDown}, "down"},
+		{"list", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}, "down"},
+		{"detail", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")}, ""},
+		{"help", tea.KeyMsg{Type: tea.KeyEsc}, "back"},
+		{"global", tea.KeyMsg{Type: tea.KeyCtrlC}, "forceQuit"},
+	}
+
+	for _, test := range tests {
+		result := keyMap.action(test.inputView, test.inputKey)
+		if test.expectedAction != result {
+			t.Errorf("action(%v, %v): expectedAction %v, got %v", test.inputView, test.inputKey, test.expectedAction, result)
+		}
+	}
+}
+
+func TestKeyMapKeyIndex(t *testing.T) {
+	keyMap, _ := newKeyMap(nil)
+
+	var tests = []struct {
+		inputKey      tea.KeyMsg
+		expectedIndex int
+	}{
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")}, 0},
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")}, 8},
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("0")}, -1},
+	}
+
+	for _, test := range tests {
+		result := keyMap.keyIndex("list", "selectView", test.inputKey)
+		if test.expectedIndex != result {
+			t.Errorf("keyIndex(%v): expectedIndex %v, got %v", test.inputKey, test.expectedIndex, result)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package tui
+
+import (
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Result of the links list filter.
+type walgotLinkResult struct {
+	// Index of the link in the entry links:
+	Index int
+	Score int
+	// Matched characters (rune index) of the text and URL:
+	TextPositions []int
+	URLPositions  []int
+}
+
+// Fuzzy find links matching the query in their text or URL, or by their number,
+// best matches first. Space separated terms all need to match.
+func fuzzyFindLinks(links []walgotLink, query string) []walgotLinkResult {
+	terms := strings.Fields(query)
+	var results []walgotLinkResult
+
+	for i, link := range links {
+		result := walgotLinkResult{Index: i}
+		matched := true
+
+		for _, term := range terms {
+			textScore, textPositions, textOk := fuzzyMatch(term, link.Text)
+			urlScore, urlPositions, urlOk := fuzzyMatch(term, link.URL)
+
+			// Keep the best matching field, the link number being the best match:
+			if term == strconv.Itoa(i+1) {
+				result.Score += fuzzyScoreMatch * 4
+			} else if textOk && (!urlOk || textScore >= urlScore) {
+				result.Score += textScore
+				result.TextPositions = append(result.TextPositions, textPositions...)
+			} else if urlOk {
+				result.Score += urlScore
+				result.URLPositions = append(result.URLPositions, urlPositions...)
+			} else {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results
+}
+
+// Find the marker of a link in rendered content lines, eg: "[2]", skipping footnotes.
+// Returns the line index and the marker position in the line without styles, -1 if not found.
+func findContentLink(lines []string, number int) (int, int) {
+	marker := "[" + strconv.Itoa(number) + "]"
+	for i, line := range lines {
+		text := stripStyles(line)
+		if strings.HasPrefix(strings.TrimLeft(text, " "), marker+":") {
+			continue
+		}
+		if position := strings.Index(text, marker); position >= 0 {
+			return i, position
+		}
+	}
+
+	return -1, -1
+}
+
+// Highlight a link in rendered content, with its text when on the same line.
+// Styles of the highlighted line are removed, to keep the highlight readable.
+func highlightContentLink(content string, link walgotLink, number int, style lipgloss.Style) string {
+	lines := strings.Split(content, "\n")
+	index, position := findContentLink(lines, number)
+	if index < 0 {
+		return content
+	}
+
+	text := stripStyles(lines[index])
+	start, end := position, position+len("["+strconv.Itoa(number)+"]")
+	// Longest end of the link text found before its marker:
+	words := strings.Fields(link.Text)
+	for i := range words {
+		if suffix := strings.Join(words[i:], " ") + " "; strings.HasSuffix(text[:position], suffix) {
+			start = position - len(suffix)
+			break
+		}
+	}
+	lines[index] = text[:start] + style.Render(text[start:end]) + text[end:]
+
+	return strings.Join(lines, "\n")
+}
+
+// Focus a link of the entry read, scrolling to it if it isn't visible.
+// Number 0 removes the focus.
+func (m *model) focusLink(number int) {
+	m.LinkFocus = number
+	content := m.showLinkFocus()
+	if number == 0 {
+		return
+	}
+
+	line, _ := findContentLink(strings.Split(content, "\n"), number)
+	if line >= 0 && (line < m.Viewport.YOffset || line >= m.Viewport.YOffset+m.Viewport.Height) {
+		m.Viewport.SetYOffset(line - m.Viewport.Height/3)
+	}
+}
+
+// Retrieve the link to focus after (or before, going backward) the focused one,
+// starting from the first visible link if none is focused.
+func (m *model) getNextLink(nbLinks int, backward bool) int {
+	if m.LinkFocus == 0 {
+		lines := strings.Split(m.Content, "\n")
+		for number := 1; number <= nbLinks; number++ {
+			if line, _ := findContentLink(lines, number); line >= m.Viewport.YOffset {
+				return number
+			}
+		}
+		return 1
+	}
+
+	if backward {
+		return (m.LinkFocus+nbLinks-2)%nbLinks + 1
+	}
+
+	return m.LinkFocus%nbLinks + 1
+}
+
+// Retrieve the tag of links saved from an entry, eg: "via: Article title".
+// Commas would split the tag.
+func getViaTag(title string) string {
+	title = strings.Join(strings.Fields(strings.ReplaceAll(title, ",", " ")), " ")
+	if title == "" {
+		return ""
+	}
+
+	return "via: " + title
+}
+
+// Display a dialog to save a link of the entry read, with optional tags.
+func showSaveLinkDialog(m *model, link walgotLink) {
+	m.Dialog.TextInput.Reset()
+	m.Dialog.TextInput.Placeholder = "Tags, comma separated (optional)"
+	m.Dialog.TextInput.CharLimit = 0
+	if index := getSelectedEntryIndex(m.Entries, m.SelectedID); m.ViaTag && index >= 0 {
+		m.Dialog.TextInput.SetValue(getViaTag(m.Entries[index].Title))
+		m.Dialog.TextInput.CursorEnd()
+	}
+	m.Dialog.ShowInput = true
+	m.Dialog.Action = "save link"
+	m.Dialog.Link = link.URL
+	m.Dialog.Message = "Save this link to wallabag?\n" + link.URL + "\n"
+}
+
+// Open, copy or save to wallabag a link of the entry read.
+func linkActionCommand(m *model, action string, link walgotLink) tea.Cmd {
+	switch action {
+	case "open":
+		if err := openLinkInBrowser(link.URL); err != nil {
+			m.Dialog.Message = "Couldn't open link in browser"
+			if m.DebugMode {
+				log.Println("Error while opening link")
+				log.Println(err)
+			}
+			return nil
+		}
+		m.UpdateMessage = "Link opened in browser"
+
+	case "copy":
+		if err := m.copyLinkToClipboard(link.URL); err != nil {
+			m.Dialog.Message = "Couldn't copy link"
+			if m.DebugMode {
+				log.Println("Error while copying link")
+				log.Println(err)
+			}
+			return nil
+		}
+		m.UpdateMessage = "Link copied"
+
+	case "save":
+		// No need to call wallabag if the link is already saved:
+		if index := getEntryIndexByURL(m.Entries, link.URL); index >= 0 {
+			showDuplicateEntryDialog(m, m.Entries[index].ID)
+			return nil
+		}
+		showSaveLinkDialog(m, link)
+		return textinput.Blink
+	}
+
+	return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+		return wallabagoResponseClearMsg(true)
+	})
+}
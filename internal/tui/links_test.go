@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestFuzzyFindLinks(t *testing.T) {
+	links := []walgotLink{
+		{URL: "https://example.org/go", Text: "Go release notes"},
+		{URL: "https://golang.org/doc"},
+		{URL: "https://example.org/rust", Text: "Rust book"},
+	}
+
+	var tests = []struct {
+		query    string
+		expected []int
+	}{
+		{"", []int{0, 1, 2}},
+		{"rust", []int{2}},
+		{"golang", []int{1}},
+		{"example notes", []int{0}},
+		// Link number first:
+		{"3", []int{2}},
+		{"1", []int{0}},
+		{"python", nil},
+	}
+
+	for _, test := range tests {
+		var indexes []int
+		for _, result := range fuzzyFindLinks(links, test.query) {
+			indexes = append(indexes, result.Index)
+		}
+		if !reflect.DeepEqual(indexes, test.expected) {
+			t.Errorf("fuzzyFindLinks(%q): expected %v, got %v", test.query, test.expected, indexes)
+		}
+	}
+}
+
+func TestFindContentLink(t *testing.T) {
+	lines := []string{
+		"  A \x1b[1mlink [\x1b[0m1], and",
+		"  another one [2].",
+		"  Links:",
+		"  [1]: https://example.org",
+		"  [2]: https://example.org/2",
+		"  [3]: https://example.org/3",
+	}
+
+	var tests = []struct {
+		number           int
+		expectedLine     int
+		expectedPosition int
+	}{
+		{1, 0, 9},
+		{2, 1, 14},
+		// Only in footnotes:
+		{3, -1, -1},
+	}
+
+	for _, test := range tests {
+		line, position := findContentLink(lines, test.number)
+		if line != test.expectedLine || position != test.expectedPosition {
+			t.Errorf("findContentLink(%v): expected %v, %v, got %v, %v", test.number, test.expectedLine, test.expectedPosition, line, position)
+		}
+	}
+}
+
+func TestHighlightContentLink(t *testing.T) {
+	content := "  Read the \x1b[1mGo\x1b[0m release notes [1].\n  [1]: https://example.org"
+	style := lipgloss.NewStyle().Reverse(true)
+
+	var tests = []struct {
+		link        walgotLink
+		highlighted string
+	}{
+		{walgotLink{URL: "https://example.org", Text: "Go release notes"}, "Go release notes [1]"},
+		// Only the end of the text is on the same line:
+		{walgotLink{URL: "https://example.org", Text: "the new Go release notes"}, "Go release notes [1]"},
+		{walgotLink{URL: "https://example.org", Text: "Something else"}, "[1]"},
+	}
+
+	for _, test := range tests {
+		lines := strings.Split(highlightContentLink(content, test.link, 1, style), "\n")
+		expected := "  Read the " + strings.TrimSuffix("Go release notes [1]", test.highlighted) + style.Render(test.highlighted) + "."
+		if lines[0] != expected {
+			t.Errorf("highlightContentLink(%q): expected %q, got %q", test.link.Text, expected, lines[0])
+		}
+		if lines[1] != "  [1]: https://example.org" {
+			t.Errorf("highlightContentLink(%q): footnotes shouldn't be highlighted, got %q", test.link.Text, lines[1])
+		}
+	}
+}
+
+func TestGetViaTag(t *testing.T) {
+	var tests = []struct {
+		title    string
+		expected string
+	}{
+		{"Go release notes", "via: Go release notes"},
+		{"Cats,  dogs and birds", "via: Cats dogs and birds"},
+		{" ", ""},
+	}
+
+	for _, test := range tests {
+		if tag := getViaTag(test.title); tag != test.expected {
+			t.Errorf("getViaTag(%q): expected %q, got %q", test.title, test.expected, tag)
+		}
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"strings"
 	"time"
 
+	"git.bacardi55.io/bacardi55/walgot/internal/api"
+
 	"github.com/Strubbl/wallabago/v7"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -15,14 +18,396 @@ import (
 func updateHelpView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "esc":
+		switch m.KeyMap.action("help", msg) {
+		case "back":
+			m.CurrentView = "list"
+		}
+	}
+	return m, nil
+}
+
+// Manage update messages on the domains view.
+func updateDomainsView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.KeyMap.action("domains", msg) {
+		case "back":
+			m.CurrentView = "list"
+		case "select":
+			// The table selected row panics without rows:
+			if len(getDomains(m.Entries)) == 0 {
+				return m, nil
+			}
+			m.Options.Filters.Domain = m.DomainTable.SelectedRow()[0]
+			m.CurrentView = "list"
+			m.refreshTableRows()
+			m.Table.GotoTop()
+		case "down":
+			m.DomainTable.MoveDown(1)
+		case "pageDown":
+			m.DomainTable.MoveDown(10)
+		case "up":
+			m.DomainTable.MoveUp(1)
+		case "pageUp":
+			m.DomainTable.MoveUp(10)
+		case "top":
+			m.DomainTable.GotoTop()
+		case "bottom":
+			m.DomainTable.GotoBottom()
+		}
+	}
+	return m, nil
+}
+
+// Manage update messages on the saved views view.
+func updateSavedViewsView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.KeyMap.action("views", msg) {
+		case "back":
+			m.CurrentView = "list"
+		case "select":
+			// The table selected row panics without rows:
+			if len(m.SavedViews) == 0 {
+				return m, nil
+			}
+			index, _ := strconv.Atoi(m.ViewTable.SelectedRow()[0])
 			m.CurrentView = "list"
+			m.applySavedView(m.SavedViews[index-1])
+		case "down":
+			m.ViewTable.MoveDown(1)
+		case "up":
+			m.ViewTable.MoveUp(1)
+		case "top":
+			m.ViewTable.GotoTop()
+		case "bottom":
+			m.ViewTable.GotoBottom()
 		}
 	}
 	return m, nil
 }
 
+// Manage update messages on the fuzzy finder.
+func updateFinderView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	finder := &m.Finder
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.KeyMap.action("finder", msg) {
+		case "close":
+			finder.Input.Blur()
+			m.CurrentView = "list"
+			return m, nil
+		case "select":
+			if len(finder.Results) == 0 {
+				return m, nil
+			}
+			sID := m.Entries[finder.Results[finder.Cursor].Index].ID
+			finder.Input.Blur()
+			m.CurrentView = "list"
+			m.Viewport.GotoTop()
+			return m, selectEntryCommand(sID)
+		case "up":
+			if finder.Cursor > 0 {
+				finder.Cursor--
+			}
+			return m, nil
+		case "down":
+			if finder.Cursor < len(finder.Results)-1 {
+				finder.Cursor++
+			}
+			return m, nil
+		}
+	}
+
+	// Results are updated as you type:
+	query := finder.Input.Value()
+	finder.Input, cmd = finder.Input.Update(msg)
+	if finder.Input.Value() != query {
+		finder.Results = fuzzyFindEntries(m.Entries, finder.Input.Value())
+		finder.Cursor = 0
+	}
+
+	return m, cmd
+}
+
+// Manage update messages on the command palette.
+func updatePaletteView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	palette := &m.Palette
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch m.KeyMap.action("palette", msg) {
+		case "close":
+			palette.Input.Blur()
+			m.CurrentView = palette.View
+			return m, nil
+		case "select":
+			line := strings.TrimSpace(palette.Input.Value())
+			fields := strings.Fields(line)
+			// Use the selected command if the typed one isn't complete:
+			if len(palette.Suggestions) > 0 && (len(fields) == 0 || !isPaletteCommand(m.KeyMap, palette.View, fields[0])) {
+				selected := palette.Suggestions[palette.Cursor]
+				if selected.NeedsArgs && len(fields) < 2 {
+					// Prompt for arguments:
+					setPaletteInput(m, selected.Name+" ")
+					return m, nil
+				}
+				line = selected.Name
+				if len(fields) > 1 {
+					line += " " + strings.Join(fields[1:], " ")
+				}
+			}
+			palette.History = addPaletteHistory(palette.History, line)
+			palette.HistoryIndex = len(palette.History)
+			palette.Input.Blur()
+			m.CurrentView = palette.View
+
+			updated, cmd, err := runPaletteCommand(m, line)
+			if err != nil {
+				m.CurrentView = "palette"
+				palette.Input.Focus()
+				palette.Error = err.Error()
+				return m, nil
+			}
+			return updated, cmd
+		case "complete":
+			if len(palette.Suggestions) > 0 {
+				setPaletteInput(m, palette.Suggestions[palette.Cursor].Name+" ")
+			}
+			return m, nil
+		case "up":
+			if palette.Cursor > 0 {
+				palette.Cursor--
+			}
+			return m, nil
+		case "down":
+			if palette.Cursor < len(palette.Suggestions)-1 {
+				palette.Cursor++
+			}
+			return m, nil
+		case "historyPrevious":
+			if palette.HistoryIndex > 0 {
+				palette.HistoryIndex--
+				setPaletteInput(m, palette.History[palette.HistoryIndex])
+			}
+			return m, nil
+		case "historyNext":
+			if palette.HistoryIndex < len(palette.History)-1 {
+				palette.HistoryIndex++
+				setPaletteInput(m, palette.History[palette.HistoryIndex])
+			} else {
+				palette.HistoryIndex = len(palette.History)
+				setPaletteInput(m, "")
+			}
+			return m, nil
+		}
+	}
+
+	// Suggestions are updated as you type:
+	query := palette.Input.Value()
+	palette.Input, cmd = palette.Input.Update(msg)
+	if palette.Input.Value() != query {
+		palette.Suggestions = getPaletteSuggestions(m.KeyMap, palette.View, palette.Input.Value())
+		palette.Cursor = 0
+		palette.Error = ""
+	}
+
+	return m, cmd
+}
+
+// Manage update messages on the links list overlay.
+func updateLinksView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	list := &m.LinkList
+
+	switch msg := msg.(type) {
+	// Resizing still needs to be managed by the list view:
+	case tea.WindowSizeMsg:
+		return updateListView(msg, *m)
+
+	case tea.KeyMsg:
+		keyAction := m.KeyMap.action("links", msg)
+		switch keyAction {
+		case "close":
+			list.Input.Blur()
+			m.CurrentView = "detail"
+			return m, nil
+		case "select", "focus", "copy", "save":
+			if len(list.Results) == 0 {
+				return m, nil
+			}
+			number := list.Results[list.Cursor].Index + 1
+			list.Input.Blur()
+			m.CurrentView = "detail"
+			if keyAction == "focus" {
+				m.focusLink(number)
+				return m, nil
+			}
+			action := keyAction
+			if action == "select" {
+				action = "open"
+			}
+			return m, linkActionCommand(m, action, list.Links[number-1])
+		case "up":
+			if list.Cursor > 0 {
+				list.Cursor--
+			}
+			return m, nil
+		case "down":
+			if list.Cursor < len(list.Results)-1 {
+				list.Cursor++
+			}
+			return m, nil
+		}
+	}
+
+	// Results are updated as you type:
+	query := list.Input.Value()
+	list.Input, cmd = list.Input.Update(msg)
+	if list.Input.Value() != query {
+		list.Results = fuzzyFindLinks(list.Links, list.Input.Value())
+		list.Cursor = 0
+	}
+
+	return m, cmd
+}
+
+// Manage update messages on the images list overlay.
+func updateImagesView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	list := &m.ImageList
+
+	switch msg := msg.(type) {
+	// Resizing still needs to be managed by the list view:
+	case tea.WindowSizeMsg:
+		return updateListView(msg, *m)
+
+	case tea.KeyMsg:
+		switch m.KeyMap.action("images", msg) {
+		case "close":
+			list.Input.Blur()
+			m.CurrentView = "detail"
+			return m, nil
+		case "select":
+			if len(list.Results) == 0 {
+				return m, nil
+			}
+			list.Input.Blur()
+			m.CurrentView = "detail"
+			return m, m.openImage(list.Links[list.Results[list.Cursor].Index].URL)
+		case "copy":
+			if len(list.Results) == 0 {
+				return m, nil
+			}
+			list.Input.Blur()
+			m.CurrentView = "detail"
+			return m, linkActionCommand(m, "copy", list.Links[list.Results[list.Cursor].Index])
+		case "up":
+			if list.Cursor > 0 {
+				list.Cursor--
+			}
+			return m, nil
+		case "down":
+			if list.Cursor < len(list.Results)-1 {
+				list.Cursor++
+			}
+			return m, nil
+		}
+	}
+
+	// Results are updated as you type:
+	query := list.Input.Value()
+	list.Input, cmd = list.Input.Update(msg)
+	if list.Input.Value() != query {
+		list.Results = fuzzyFindLinks(list.Links, list.Input.Value())
+		list.Cursor = 0
+	}
+
+	return m, cmd
+}
+
+// Manage update messages on the table of contents overlay.
+func updateTOCView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	// Resizing still needs to be managed by the list view:
+	case tea.WindowSizeMsg:
+		return updateListView(msg, *m)
+
+	case tea.KeyMsg:
+		switch m.KeyMap.action("toc", msg) {
+		case "close":
+			m.CurrentView = "detail"
+		case "select":
+			m.CurrentView = "detail"
+			m.gotoHeading(m.TOCCursor)
+		case "up":
+			if m.TOCCursor > 0 {
+				m.TOCCursor--
+			}
+		case "down":
+			if m.TOCCursor < len(m.Headings)-1 {
+				m.TOCCursor++
+			}
+		case "top":
+			m.TOCCursor = 0
+		case "bottom":
+			m.TOCCursor = len(m.Headings) - 1
+		}
+	}
+
+	return m, nil
+}
+
+// Manage update messages on the share menu overlay.
+func updateShareView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	// Resizing still needs to be managed by the list view:
+	case tea.WindowSizeMsg:
+		return updateListView(msg, *m)
+
+	case tea.KeyMsg:
+		switch m.KeyMap.action("share", msg) {
+		case "close":
+			m.CurrentView = m.Share.View
+		case "select":
+			m.CurrentView = m.Share.View
+			return m, m.shareEntry(shareOptions[m.Share.Cursor].Action)
+		case "up":
+			if m.Share.Cursor > 0 {
+				m.Share.Cursor--
+			}
+		case "down":
+			if m.Share.Cursor < len(shareOptions)-1 {
+				m.Share.Cursor++
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Open the share menu for the selected entry, from the current view.
+func openShareMenu(m *model) {
+	entry := getPaletteSelectedEntry(m)
+	if entry == nil {
+		return
+	}
+	m.Share = walgotShare{EntryID: entry.ID, View: m.CurrentView}
+	m.CurrentView = "share"
+}
+
+// Set the command palette input, and update suggestions.
+func setPaletteInput(m *model, value string) {
+	m.Palette.Input.SetValue(value)
+	m.Palette.Input.CursorEnd()
+	m.Palette.Suggestions = getPaletteSuggestions(m.KeyMap, m.Palette.View, value)
+	m.Palette.Cursor = 0
+	m.Palette.Error = ""
+}
+
 // Manage update messages for the detail entry view.
 func updateEntryView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -32,68 +417,226 @@ func updateEntryView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 	// A row has been selected, display article detail:
 	case walgotSelectRowMsg:
 		m.CurrentView = "detail"
-		m.Viewport.SetContent(getDetailViewportContent(m.SelectedID, m.Entries, m.TermSize.Width))
+		m.LinkFocus = 0
+		m.ContentSearch = walgotContentSearch{}
+		m.stopSpeechProcess()
+		m.Speech = walgotSpeech{}
+		m.setDetailContent(m.SelectedID)
+		cmds = append(cmds, m.getImagesCommand(m.SelectedID))
 
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "q":
+	case tea.KeyMsg, walgotActionMsg:
+		keyAction, _ := m.KeyMap.messageAction("detail", msg)
+		switch keyAction {
+		case "back", "switchPane":
+			// Switching pane is only possible in split view, the list being displayed:
+			if keyAction == "switchPane" && !m.isSplitView() {
+				break
+			}
 			m.CurrentView = "list"
+			m.LinkFocus = 0
+			m.ContentSearch = walgotContentSearch{}
+			m.stopSpeechProcess()
+			m.Speech = walgotSpeech{}
+			// Keep reading progress for the list:
+			m.ReadingProgress[m.SelectedID] = int(m.Viewport.ScrollPercent() * 100)
+			m.refreshTableRows()
 			// Reset selection.
 			m.SelectedID = 0
 			// Make sure to scrollback up for other articles:
 			m.Viewport.GotoTop()
-		case "j", "down":
+			m.updatePreview()
+		case "toggleSplit":
+			return m, toggleSplitView(m)
+
+		// Read the next or previous entry of the list:
+		case "nextEntry", "previousEntry":
+			sID := m.SelectedID
+			nextID := getAdjacentEntryID(m.Entries, m.Options.Filters, sID, keyAction == "previousEntry")
+			if nextID == 0 {
+				m.UpdateMessage = "No next article in the list"
+				if keyAction == "previousEntry" {
+					m.UpdateMessage = "No previous article in the list"
+				}
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+
+			if keyAction == "nextEntry" && m.ArchiveOnNext && m.Entries[getSelectedEntryIndex(m.Entries, sID)].IsArchived == 0 {
+				fields, action := sendEntryUpdate("toggleArchive", sID, m)
+				m.UpdateMessage = action
+				cmds = append(cmds, requestWallabagEntryUpdate(sID, fields))
+			}
+			m.stopSpeechProcess()
+			m.Speech = walgotSpeech{}
+			// Keep reading progress for the list:
+			m.ReadingProgress[sID] = int(m.Viewport.ScrollPercent() * 100)
+			m.SelectedID = nextID
+			m.refreshTableRows()
+			m.Viewport.GotoTop()
+			cmds = append(cmds, selectEntryCommand(nextID))
+			return m, tea.Batch(cmds...)
+
+		case "widerText", "narrowerText", "toggleAlign", "toggleSpacing", "toggleHyphenation":
+			return m, readerUpdate(keyAction, m)
+		case "down":
 			m.Viewport.LineDown(1)
-		case "k", "up":
+		case "up":
 			m.Viewport.LineUp(1)
-		case "pagedown":
+		case "pageDown":
 			m.Viewport.HalfViewDown()
-		case "pageup":
+		case "pageUp":
 			m.Viewport.HalfViewUp()
-		case "alt+[H":
+		case "top":
 			m.Viewport.GotoTop()
-		case "alt+[F":
+		case "bottom":
 			m.Viewport.GotoBottom()
 
 		// Update article (archive, starred, public):
-		case "A", "S", "P":
+		case "toggleArchive", "toggleStarred", "togglePublic":
 			sID := m.SelectedID
-			a, s, p, action := sendEntryUpdate(msg.String(), m.SelectedID, m)
+			fields, action := sendEntryUpdate(keyAction, m.SelectedID, m)
 			if m.DebugMode {
-				log.Println("Update entry action:", action, a, s)
+				log.Println("Update entry action:", action, fields)
 			}
 			m.UpdateMessage = action
-			return m, requestWallabagEntryUpdate(
-				sID,
-				a,
-				s,
-				p,
-			)
-
-		// Open links in entry:
-		case "L":
-			// Configure textinput:
-			m.Dialog.TextInput.Placeholder = "Link number"
-			m.Dialog.TextInput.CharLimit = 3
-			// Display textinput
+			return m, requestWallabagEntryUpdate(sID, fields)
+
+		// Reload entry content:
+		case "reloadEntry":
+			m.UpdateMessage = "Reloading entry content…"
+			return m, requestWallabagEntriesReload([]int{m.SelectedID})
+
+		// Command palette:
+		case "palette":
+			m.Palette = newPalette(m.KeyMap, "detail", m.Palette.History)
+			m.CurrentView = "palette"
+			return m, textinput.Blink
+
+		// Edit entry metadata:
+		case "edit":
+			entry := &m.Entries[getSelectedEntryIndex(m.Entries, m.SelectedID)]
+			m.EditForm = newEditForm(entry, getKnownTags(m.Entries))
+			setEditFormFocus(&m.EditForm, editFormTitle)
+			m.CurrentView = "edit"
+			return m, nil
+
+		// Links in entry:
+		case "links", "nextLink", "previousLink", "openLink", "yankLink", "saveLink":
+			links := m.Links
+			if len(links) == 0 {
+				m.UpdateMessage = "No link in this article"
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+
+			switch keyAction {
+			case "links":
+				m.LinkList = newLinkList(links)
+				m.CurrentView = "links"
+				return m, textinput.Blink
+			case "nextLink", "previousLink":
+				m.focusLink(m.getNextLink(len(links), keyAction == "previousLink"))
+				return m, nil
+			}
+
+			// Other actions are on the focused link:
+			if m.LinkFocus == 0 || m.LinkFocus > len(links) {
+				m.UpdateMessage = "No link focused, focus one with " + m.KeyMap.helpKey("detail", "nextLink")
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+			action := map[string]string{"openLink": "open", "yankLink": "copy", "saveLink": "save"}[keyAction]
+			return m, linkActionCommand(m, action, links[m.LinkFocus-1])
+		case "unfocusLink":
+			if m.LinkFocus > 0 {
+				m.focusLink(0)
+			} else if m.ContentSearch.Term != "" {
+				m.searchContent("")
+			}
+
+		// Search within entry:
+		case "search":
+			m.Dialog.TextInput.Reset()
+			m.Dialog.TextInput.Placeholder = "Search"
+			m.Dialog.TextInput.CharLimit = 55
+			// Pre-fill the current search, to refine it:
+			m.Dialog.TextInput.SetValue(m.ContentSearch.Term)
+			m.Dialog.TextInput.CursorEnd()
 			m.Dialog.ShowInput = true
-			// Add search button:
-			m.Dialog.Action = "open link"
-			// Dialog title:
-			m.Dialog.Message = "Enter the link number to open:\n"
-			// Set current view to dialog:
-			m.CurrentView = "dialog"
+			m.Dialog.Action = "search content"
+			m.Dialog.Message = "Search in article:\n"
+		case "nextMatch", "previousMatch":
+			if m.ContentSearch.Count == 0 {
+				m.UpdateMessage = "No match, search with " + m.KeyMap.helpKey("detail", "search")
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+			m.focusMatch(m.getNextMatch(keyAction == "previousMatch"))
+			return m, nil
+
+		// Open in an external program:
+		case "pager", "editor":
+			cmd, err := m.openExternal(keyAction, externalFormatText)
+			if err != nil {
+				if m.DebugMode {
+					log.Println("Error while opening article in " + keyAction)
+					log.Println(err)
+				}
+				m.Dialog.Message = "Couldn't open article in " + keyAction
+			}
+			return m, cmd
+
+		// Read aloud:
+		case "speak", "speechNext", "speechPrevious", "speechStop":
+			return m, speechUpdate(keyAction, m)
+
+		// Table of contents, starting on the section being read:
+		case "toc":
+			if len(m.Headings) == 0 {
+				m.UpdateMessage = "No heading in this article"
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+			m.TOCCursor = m.getReadHeading()
+			if m.TOCCursor < 0 {
+				m.TOCCursor = 0
+			}
+			m.CurrentView = "toc"
+			return m, nil
+
+		// Open images in entry:
+		case "images":
+			_, images := getEntryContentAndImages(&m.Entries[getSelectedEntryIndex(m.Entries, m.SelectedID)])
+			if len(images) == 0 {
+				m.UpdateMessage = "No image in this article"
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+			m.ImageList = newImageList(images)
+			m.CurrentView = "images"
+			return m, textinput.Blink
+
+		// Share menu:
+		case "share":
+			openShareMenu(m)
+			return m, nil
 
 		// Open or Copy URL:
-		case "O", "Y":
+		case "open", "yank":
 			entry := &m.Entries[getSelectedEntryIndex(m.Entries, m.SelectedID)]
 			url := entry.URL
 			// If entry is public, open the public link:
-			if entry.IsPublic {
-				url = wallabago.Config.WallabagURL + "/share/" + entry.UID
+			if publicURL := getPublicURL(entry); publicURL != "" {
+				url = publicURL
 			}
 
-			if msg.String() == "O" {
+			if keyAction == "open" {
 				// Open URL in browser:
 				if err := openLinkInBrowser(url); err != nil {
 					m.Dialog.Message = "Couldn't open link in browser"
@@ -104,9 +647,9 @@ func updateEntryView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 				m.UpdateMessage = "Link opened in browser"
-			} else if msg.String() == "Y" {
+			} else {
 				// Copy URL:
-				if err := copyLinkToClipboard(url); err != nil {
+				if err := m.copyLinkToClipboard(url); err != nil {
 					m.Dialog.Message = "Couldn't copy link"
 					if m.DebugMode {
 						log.Println("Error while copying link")
@@ -122,8 +665,10 @@ func updateEntryView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 			})
 
 		// Delete:
-		case "D":
+		case "delete":
 			sID := m.SelectedID
+			m.stopSpeechProcess()
+			m.Speech = walgotSpeech{}
 			m.SelectedID = 0
 			m.CurrentView = "list"
 			return m, requestWallabagEntryDelete(sID)
@@ -140,26 +685,32 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "enter":
-			if !m.Reloading {
-				sID, _ := strconv.Atoi(m.Table.SelectedRow()[0])
+	case tea.KeyMsg, walgotActionMsg:
+		keyAction, keyIndex := m.KeyMap.messageAction("list", msg)
+		switch keyAction {
+		case "select", "switchPane":
+			// Switching pane is only possible in split view, the entry being displayed:
+			if keyAction == "switchPane" && !m.isSplitView() {
+				break
+			}
+			if sID := m.getListSelectedID(); !m.Reloading && sID > 0 {
 				return m, selectEntryCommand(sID)
 			}
-		case "j", "down":
+		case "toggleSplit":
+			return m, toggleSplitView(&m)
+		case "down":
 			m.Table.MoveDown(1)
-		case "pgdown":
+		case "pageDown":
 			m.Table.MoveDown(10)
-		case "k", "up":
+		case "up":
 			m.Table.MoveUp(1)
-		case "pgup":
+		case "pageUp":
 			m.Table.MoveUp(10)
-		case "alt+[H":
+		case "top":
 			m.Table.GotoTop()
-		case "alt+[F":
+		case "bottom":
 			m.Table.GotoBottom()
-		case "q":
+		case "quit":
 			// If search active, clean it and don't quit:
 			if m.Options.Filters.Search != "" {
 				return m, func() tea.Msg {
@@ -167,7 +718,7 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 				}
 			}
 			return m, tea.Quit
-		case "r":
+		case "reload":
 			// If already reloading, do nothing
 			if m.Reloading {
 				return m, nil
@@ -178,36 +729,130 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 			m.TotalEntriesOnServer = 0
 			return m, requestWallabagNbEntries
 
+		// Reload entry content:
+		case "reloadEntry":
+			sID := m.getListSelectedID()
+			if m.Reloading || sID == 0 {
+				return m, nil
+			}
+			m.UpdateMessage = "Reloading entry content…"
+			return m, requestWallabagEntriesReload([]int{sID})
+
+		// Reload all entries without content:
+		case "reloadFailed":
+			if m.Reloading {
+				return m, nil
+			}
+			ids := getFailedEntryIDs(m.Entries)
+			if len(ids) == 0 {
+				m.UpdateMessage = "No entry without content"
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+			m.UpdateMessage = "Reloading " + strconv.Itoa(len(ids)) + " entries without content…"
+			return m, requestWallabagEntriesReload(ids)
+
 		// Filters for the table list:
-		case "u", "s", "a", "p":
-			listViewFiltersUpdate(msg.String(), &m)
+		case "filterUnread", "filterStarred", "filterArchived", "filterPublic", "filterFailed", "filterShort", "filterLong":
+			listViewFiltersUpdate(keyAction, &m)
+
+		// Filter on the selected entry domain, or remove the domain filter:
+		case "filterDomain":
+			if m.Options.Filters.Domain != "" {
+				m.Options.Filters.Domain = ""
+			} else if sID := m.getListSelectedID(); sID > 0 {
+				m.Options.Filters.Domain = m.Entries[getSelectedEntryIndex(m.Entries, sID)].DomainName
+			}
+			m.refreshTableRows()
+			m.Table.GotoTop()
+
+		// Command palette:
+		case "palette":
+			if m.Reloading {
+				return m, nil
+			}
+			m.Palette = newPalette(m.KeyMap, "list", m.Palette.History)
+			m.CurrentView = "palette"
+			return m, textinput.Blink
+
+		// Fuzzy finder:
+		case "finder":
+			if m.Reloading {
+				return m, nil
+			}
+			m.Finder = newFinder(m.Entries)
+			m.CurrentView = "finder"
+			return m, textinput.Blink
+
+		// Saved views:
+		case "views":
+			if m.Reloading {
+				return m, nil
+			}
+			m.ViewTable.SetRows(getSavedViewTableRows(m.SavedViews))
+			m.ViewTable.GotoTop()
+			m.CurrentView = "views"
+		case "selectView":
+			if keyIndex < 0 || keyIndex >= len(m.SavedViews) {
+				m.UpdateMessage = "No view number " + strconv.Itoa(keyIndex+1)
+				return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+					return wallabagoResponseClearMsg(true)
+				})
+			}
+			m.applySavedView(m.SavedViews[keyIndex])
+		case "saveView":
+			if m.Reloading {
+				return m, nil
+			}
+			m.Dialog.TextInput.Placeholder = "View name"
+			m.Dialog.TextInput.CharLimit = 30
+			m.Dialog.ShowInput = true
+			m.Dialog.Action = "save view"
+			m.Dialog.Message = "Save current filters, sort and columns as view:\n"
+			m.CurrentView = "dialog"
+
+		// Display domains:
+		case "domains":
+			if m.Reloading {
+				return m, nil
+			}
+			m.DomainTable.SetRows(getDomainTableRows(getDomains(m.Entries)))
+			m.DomainTable.GotoTop()
+			m.CurrentView = "domains"
 
 		// Update entry status:
-		case "A", "S", "P":
-			sID, _ := strconv.Atoi(m.Table.SelectedRow()[0])
-			a, s, p, action := sendEntryUpdate(msg.String(), sID, &m)
+		case "toggleArchive", "toggleStarred", "togglePublic":
+			sID := m.getListSelectedID()
+			if sID == 0 {
+				return m, nil
+			}
+			fields, action := sendEntryUpdate(keyAction, sID, &m)
 			if m.DebugMode {
-				log.Println("Update entry action:", action, a, s)
+				log.Println("Update entry action:", action, fields)
 			}
 			m.UpdateMessage = action
-			return m, requestWallabagEntryUpdate(
-				sID,
-				a,
-				s,
-				p,
-			)
+			return m, requestWallabagEntryUpdate(sID, fields)
+
+		// Share menu:
+		case "share":
+			openShareMenu(&m)
+			return m, nil
 
 		// Open or Copy URL:
-		case "O", "Y":
-			sID, _ := strconv.Atoi(m.Table.SelectedRow()[0])
+		case "open", "yank":
+			sID := m.getListSelectedID()
+			if sID == 0 {
+				return m, nil
+			}
 			entry := m.Entries[getSelectedEntryIndex(m.Entries, sID)]
 			url := entry.URL
 			// If entry is public, open the public link:
-			if entry.IsPublic {
-				url = wallabago.Config.WallabagURL + "/share/" + entry.UID
+			if publicURL := getPublicURL(&entry); publicURL != "" {
+				url = publicURL
 			}
 
-			if msg.String() == "O" {
+			if keyAction == "open" {
 				// Open URL in browser:
 				if err := openLinkInBrowser(url); err != nil {
 					m.Dialog.Message = "Couldn't open link in browser"
@@ -218,9 +863,9 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 				m.UpdateMessage = "Link opened in browser"
-			} else if msg.String() == "Y" {
+			} else {
 				// Copy URL:
-				if err := copyLinkToClipboard(url); err != nil {
+				if err := m.copyLinkToClipboard(url); err != nil {
 					m.Dialog.Message = "Couldn't copy link"
 					if m.DebugMode {
 						log.Println("Error while copying link")
@@ -236,15 +881,15 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 			})
 
 		// Delete:
-		case "D":
-			if m.Reloading {
+		case "delete":
+			sID := m.getListSelectedID()
+			if m.Reloading || sID == 0 {
 				return m, nil
 			}
-			sID, _ := strconv.Atoi(m.Table.SelectedRow()[0])
 			return m, requestWallabagEntryDelete(sID)
 
 		// Search:
-		case "/":
+		case "search":
 			if m.Reloading {
 				return m, nil
 			}
@@ -261,24 +906,21 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 			m.CurrentView = "dialog"
 
 		// Add an entry:
-		case "N":
+		case "add":
 			if m.Reloading {
 				return m, nil
 			}
-			// Configure textinput:
-			m.Dialog.TextInput.Placeholder = "URL"
-			m.Dialog.TextInput.CharLimit = 0
-			// Display textinput
-			m.Dialog.ShowInput = true
-			// Add search button:
-			m.Dialog.Action = "add"
-			// Dialog title:
-			m.Dialog.Message = "Add a URL to wallabag:\n"
-			// Set current view to dialog:
-			m.CurrentView = "dialog"
+			// Start from an empty form, with tags known so far:
+			m.AddForm = newAddForm()
+			m.AddForm.KnownTags = getKnownTags(m.Entries)
+			setAddFormFocus(&m.AddForm, addFormURL)
+			// Set current view to add form:
+			m.CurrentView = "add"
+			// Pre-fill URL if one has been copied:
+			return m, readClipboardCommand()
 
 		// Clean, if needed:
-		case "esc":
+		case "clearSearch":
 			if m.Options.Filters.Search != "" {
 				// Cleaning a search.
 				m.Options.Filters.Search = ""
@@ -300,11 +942,15 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 		m.TotalEntriesOnServer = int(msg)
 		// We now have the number of entries, we can trigger
 		// the process to retrieve all these entries
+		sortField := m.Options.Sorts.Field
+		if !isAPISortField(sortField) {
+			sortField = "created"
+		}
 		return m, tea.Batch(
 			requestWallabagEntries(
 				m.TotalEntriesOnServer,
 				m.NbEntriesPerAPICall,
-				m.Options.Sorts.Field,
+				sortField,
 				m.Options.Sorts.Order,
 			),
 			m.Spinner.Tick,
@@ -318,28 +964,10 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 		if m.DebugMode {
 			log.Println("wallabagoResponseEntityMsg", len(msg))
 		}
-		m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
-
-	// Added entry response:
-	case wallabagoResponseAddEntryMsg:
-		// Add new entry at the top.
-		m.Entries = append([]wallabago.Item{msg.Entry}, m.Entries...)
-		// Recalculate table rows:
-		m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
-		// Wallabag API send a 200 even if the URL isn't good.
-		// Unfortunately, it means checking the content of the entry…
-		if strings.Contains(
-			msg.Entry.Content,
-			"wallabag can't retrieve contents for this article",
-		) {
-			m.Dialog.Message = "Wallabag couldn't retrieve content, empty entry created."
-		} else {
-			// Letting user know:
-			m.UpdateMessage = "Entry has been added successfully"
-			return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
-				return wallabagoResponseClearMsg(true)
-			})
+		if !isAPISortField(m.Options.Sorts.Field) {
+			sortEntries(m.Entries, m.Options.Sorts)
 		}
+		m.refreshTableRows()
 
 	// Deleted entry response:
 	case wallabagoResponseDeleteEntryMsg:
@@ -352,7 +980,7 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 		} else {
 			m.Entries = append(m.Entries[:index], m.Entries[index+1:]...)
 		}
-		m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
+		m.refreshTableRows()
 		// Letting user know:
 		m.UpdateMessage = "Entry has been deleted successfully"
 		return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
@@ -363,7 +991,7 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 	case walgotSearchEntryMsg:
 		m.Options.Filters.Search = string(msg)
 		// Recalculate table rows:
-		m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
+		m.refreshTableRows()
 
 	case spinner.TickMsg:
 		// Spin only if it is still displaying the reload screen:
@@ -373,7 +1001,29 @@ func updateListView(msg tea.Msg, m model) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	return m, cmd
+	// Selected entry may have changed:
+	m.updatePreview()
+
+	return m, tea.Batch(cmd, m.getImagesCommand(m.PreviewID))
+}
+
+// Toggle split view, if the terminal is wide enough.
+func toggleSplitView(m *model) tea.Cmd {
+	m.SplitView = !m.SplitView
+	if m.SplitView && !m.isSplitView() {
+		m.SplitView = false
+		m.UpdateMessage = "Split view needs a terminal of at least " + strconv.Itoa(splitViewMinWidth) + " columns"
+		return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	}
+	// Panes width changed, keep the selected entry while regenerating them:
+	cursor := m.Table.Cursor()
+	windowSizeUpdate(m)
+	m.Table.SetCursor(cursor)
+	m.updatePreview()
+
+	return nil
 }
 
 // Manage update messages for dialog view.
@@ -382,17 +1032,20 @@ func updateDialogView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc":
+		keyAction := m.KeyMap.action("dialog", msg)
+		switch keyAction {
+		case "close":
 			// Close and reset dialog box:
 			m.Dialog.Message = ""
 			m.Dialog.ShowInput = false
 			m.Dialog.Action = ""
+			m.Dialog.EntryID = 0
+			m.Dialog.Link = ""
 			m.Dialog.TextInput.Blur()
 			// Search input is not resetted though, just in case.
 			return m, nil
 
-		case "enter":
+		case "confirm":
 			input := m.Dialog.TextInput.Value()
 			action := m.Dialog.Action
 			// Cleaning dialog box:
@@ -412,49 +1065,65 @@ func updateDialogView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 					return walgotSearchEntryMsg(input)
 				})
 
-			// Save entry:
-			case "add":
-				return m, requestWallabagAddEntry(input)
-
-			case "open link":
-				_, links := getCleanedContentAndLinks(
-					m.Entries[getSelectedEntryIndex(m.Entries, m.SelectedID)].Content,
-				)
-				selected, err := strconv.Atoi(input)
-				if err != nil {
-					m.Dialog.Message = "Couldn't find link number " + input
-					if m.DebugMode {
-						log.Println("Error while opening link")
-						log.Println(err)
-					}
+			// Save URL from clipboard:
+			case "save url":
+				if index := getEntryIndexByURL(m.Entries, input); index >= 0 {
+					showDuplicateEntryDialog(m, m.Entries[index].ID)
 					return m, nil
 				}
+				return m, requestWallabagAddEntry(api.NewEntry{URL: input})
 
-				if selected < 1 || selected > len(links) {
-					m.Dialog.Message = "No link numbered " + input
-					if m.DebugMode {
-						log.Println("Error while opening link")
-						log.Println(err)
-					}
+			// Search within the entry read:
+			case "search content":
+				m.CurrentView = "detail"
+				return m, m.searchContent(input)
+
+			// Save link of the entry read, with the given tags:
+			case "save link":
+				link := m.Dialog.Link
+				m.Dialog.Link = ""
+				m.UpdateMessage = "Saving link to wallabag…"
+				return m, requestWallabagAddEntry(api.NewEntry{
+					URL:  link,
+					Tags: strings.Join(parseTags(input), ","),
+				})
+
+			// Save current filters, sort and columns:
+			case "save view":
+				name := strings.TrimSpace(input)
+				if name == "" {
+					m.Dialog.Message = "Couldn't save view without name"
 					return m, nil
 				}
+				view := getViewConfig(name, m.Options, m.ListColumns)
+				savedView, err := newSavedView(view, m.Options.Sorts, m.ListColumns)
+				if err != nil {
+					m.Dialog.Message = "Couldn't save view: " + err.Error()
+					return m, nil
+				}
+				m.SavedViews = addSavedView(m.SavedViews, savedView)
+				return m, saveViewCommand(m.ViewsFile, view)
 
-				if err := openLinkInBrowser(links[selected-1]); err != nil {
-					m.Dialog.Message = "Couldn't open link"
-					if m.DebugMode {
-						log.Println("Error while opening link")
-						log.Println(err)
-					}
+			// Back to the form the error was shown on, keeping what was typed:
+			case "add form error":
+				m.CurrentView = "add"
+				return m, nil
+			case "edit form error":
+				m.CurrentView = "edit"
+				return m, nil
+
+			// Jump to the already saved entry:
+			case "duplicate":
+				if getSelectedEntryIndex(m.Entries, m.Dialog.EntryID) < 0 {
+					m.Dialog.Message = "Entry is saved on wallabag but isn't loaded yet,\nreload the list to see it."
+					m.Dialog.EntryID = 0
 					return m, nil
 				}
-				m.UpdateMessage = "Link opened"
+				sID := m.Dialog.EntryID
+				m.Dialog.EntryID = 0
+				m.Viewport.GotoTop()
+				return m, selectEntryCommand(sID)
 
-				cmds = append(
-					cmds,
-					tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
-						return wallabagoResponseClearMsg(true)
-					}),
-				)
 			}
 		}
 	}
@@ -467,6 +1136,277 @@ func updateDialogView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// Manage update messages for the add entry form.
+func updateAddFormView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	form := &m.AddForm
+
+	switch msg := msg.(type) {
+	// Resizing still needs to be managed by the list view:
+	case tea.WindowSizeMsg:
+		return updateListView(msg, *m)
+
+	case tea.KeyMsg:
+		keyAction := m.KeyMap.action("dialog", msg)
+		switch keyAction {
+		case "close":
+			// Close form, without saving:
+			m.CurrentView = "list"
+			return m, nil
+
+		case "nextField":
+			// Complete tag if a suggestion is available:
+			if form.Focus == addFormTags {
+				value := form.Inputs[addFormTags].Value()
+				if suggestion := getTagSuggestion(value, form.KnownTags); suggestion != "" {
+					form.Inputs[addFormTags].SetValue(completeTag(value, suggestion))
+					form.Inputs[addFormTags].CursorEnd()
+					return m, nil
+				}
+			}
+			setAddFormFocus(form, (form.Focus+1)%addFormNbFields)
+			return m, nil
+
+		case "previousField":
+			setAddFormFocus(form, (form.Focus+addFormNbFields-1)%addFormNbFields)
+			return m, nil
+
+		case "toggle":
+			// Toggle checkboxes:
+			if form.Focus == addFormArchive {
+				form.Archive = !form.Archive
+				return m, nil
+			} else if form.Focus == addFormStarred {
+				form.Starred = !form.Starred
+				return m, nil
+			}
+
+		case "confirm", "submit":
+			// Confirm adds a new line in content:
+			if keyAction == "confirm" && form.Focus == addFormContent {
+				break
+			}
+			entry := getAddFormEntry(form)
+			if !isValidURL(entry.URL) {
+				m.Dialog.Message = "Error:\n Invalid URL"
+				m.Dialog.Action = "add form error"
+				setAddFormFocus(form, addFormURL)
+				return m, nil
+			}
+			// Next screen should be on list:
+			m.CurrentView = "list"
+			// No need to call wallabag if the entry is already loaded:
+			if index := getEntryIndexByURL(m.Entries, entry.URL); index >= 0 {
+				showDuplicateEntryDialog(m, m.Entries[index].ID)
+				return m, nil
+			}
+			return m, requestWallabagAddEntry(entry)
+		}
+	}
+
+	// Send message to the focused field:
+	if form.Focus == addFormContent {
+		form.Content, cmd = form.Content.Update(msg)
+	} else if form.Focus <= addFormTags {
+		form.Inputs[form.Focus], cmd = form.Inputs[form.Focus].Update(msg)
+	}
+
+	return m, cmd
+}
+
+// Move focus to the given add form field.
+func setAddFormFocus(form *walgotAddForm, focus int) {
+	form.Focus = focus
+	for i := range form.Inputs {
+		if i == focus {
+			form.Inputs[i].Focus()
+		} else {
+			form.Inputs[i].Blur()
+		}
+	}
+	if focus == addFormContent {
+		form.Content.Focus()
+	} else {
+		form.Content.Blur()
+	}
+}
+
+// Retrieve the entry to save from the add form.
+func getAddFormEntry(form *walgotAddForm) api.NewEntry {
+	entry := api.NewEntry{
+		URL:     strings.TrimSpace(form.Inputs[addFormURL].Value()),
+		Title:   strings.TrimSpace(form.Inputs[addFormTitle].Value()),
+		Tags:    strings.Join(parseTags(form.Inputs[addFormTags].Value()), ","),
+		Content: strings.TrimSpace(form.Content.Value()),
+	}
+	if form.Archive {
+		entry.Archive = 1
+	}
+	if form.Starred {
+		entry.Starred = 1
+	}
+
+	return entry
+}
+
+// Manage update messages for the edit entry form.
+func updateEditFormView(msg tea.Msg, m *model) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	form := &m.EditForm
+
+	switch msg := msg.(type) {
+	// Resizing still needs to be managed by the list view:
+	case tea.WindowSizeMsg:
+		return updateListView(msg, *m)
+
+	case tea.KeyMsg:
+		keyAction := m.KeyMap.action("dialog", msg)
+		switch keyAction {
+		case "close":
+			// Close form, without saving:
+			m.CurrentView = "detail"
+			return m, nil
+
+		case "nextField":
+			// Complete tag if a suggestion is available:
+			if form.Focus == editFormTags {
+				value := form.Inputs[editFormTags].Value()
+				if suggestion := getTagSuggestion(value, form.KnownTags); suggestion != "" {
+					form.Inputs[editFormTags].SetValue(completeTag(value, suggestion))
+					form.Inputs[editFormTags].CursorEnd()
+					return m, nil
+				}
+			}
+			setEditFormFocus(form, (form.Focus+1)%editFormNbFields)
+			return m, nil
+
+		case "previousField", "up":
+			setEditFormFocus(form, (form.Focus+editFormNbFields-1)%editFormNbFields)
+			return m, nil
+
+		case "down":
+			setEditFormFocus(form, (form.Focus+1)%editFormNbFields)
+			return m, nil
+
+		case "confirm", "submit":
+			index := getSelectedEntryIndex(m.Entries, form.EntryID)
+			if index < 0 {
+				m.CurrentView = "list"
+				return m, nil
+			}
+			fields, removedTagIDs, err := getEntryMetadataChanges(&m.Entries[index], walgotEntryMetadata{
+				Title:       form.Inputs[editFormTitle].Value(),
+				Tags:        form.Inputs[editFormTags].Value(),
+				Language:    form.Inputs[editFormLanguage].Value(),
+				OriginURL:   form.Inputs[editFormOriginURL].Value(),
+				PublishedAt: form.Inputs[editFormPublishedAt].Value(),
+				Authors:     form.Inputs[editFormAuthors].Value(),
+			})
+			if err != nil {
+				m.Dialog.Message = "Error:\n " + err.Error()
+				m.Dialog.Action = "edit form error"
+				return m, nil
+			}
+
+			m.CurrentView = "detail"
+			// Nothing changed, no need to call wallabag:
+			if len(fields) == 0 && len(removedTagIDs) == 0 {
+				return m, nil
+			}
+			if m.DebugMode {
+				log.Println("Edit entry:", fields, removedTagIDs)
+			}
+			m.UpdateMessage = "Saving changes…"
+			return m, requestWallabagEntryEdit(form.EntryID, fields, removedTagIDs)
+		}
+	}
+
+	// Send message to the focused field:
+	form.Inputs[form.Focus], cmd = form.Inputs[form.Focus].Update(msg)
+
+	return m, cmd
+}
+
+// Move focus to the given edit form field.
+func setEditFormFocus(form *walgotEditForm, focus int) {
+	form.Focus = focus
+	for i := range form.Inputs {
+		if i == focus {
+			form.Inputs[i].Focus()
+			form.Inputs[i].CursorEnd()
+		} else {
+			form.Inputs[i].Blur()
+		}
+	}
+}
+
+// Check the URL copied in the clipboard and offer to save new URLs.
+func watchClipboard(m *model, u string) {
+	if !m.Clipboard.Initialized {
+		// Don't offer what was copied before starting walgot:
+		m.Clipboard.LastContent = u
+		m.Clipboard.Initialized = true
+		return
+	}
+	if u == "" || u == m.Clipboard.LastContent {
+		return
+	}
+	// Wait for the user to be done with dialogs, forms and overlays, not to steal keystrokes:
+	if m.Reloading || m.Dialog.Message != "" || isTextInputView(m.CurrentView) || (m.CurrentView != "list" && m.CurrentView != "detail") {
+		return
+	}
+
+	m.Clipboard.LastContent = u
+	// Nothing to offer if the URL is already saved:
+	if getEntryIndexByURL(m.Entries, u) >= 0 {
+		return
+	}
+
+	m.Dialog.TextInput.Reset()
+	m.Dialog.TextInput.SetValue(u)
+	m.Dialog.TextInput.CharLimit = 0
+	m.Dialog.ShowInput = true
+	m.Dialog.Action = "save url"
+	m.Dialog.Message = "A URL has been copied, save it to wallabag?\n"
+}
+
+// Display a dialog offering to open an already saved entry.
+func showDuplicateEntryDialog(m *model, id int) {
+	message := "This URL is already saved in wallabag"
+	if index := getSelectedEntryIndex(m.Entries, id); index >= 0 {
+		message += ":\n" + m.Entries[index].Title
+	}
+	m.Dialog.Message = message + "\n"
+	m.Dialog.ShowInput = false
+	m.Dialog.Action = "duplicate"
+	m.Dialog.EntryID = id
+}
+
+// Manage added entry via API, returns the command clearing the update message if any.
+func addedEntryInModel(m *model, entry wallabago.Item) tea.Cmd {
+	// Wallabag returns the existing entry if the URL was already saved:
+	if index := getSelectedEntryIndex(m.Entries, entry.ID); index >= 0 {
+		m.Entries[index] = entry
+	} else {
+		// Add new entry at the top.
+		m.Entries = append([]wallabago.Item{entry}, m.Entries...)
+	}
+	// Recalculate table rows:
+	m.refreshTableRows()
+	// Wallabag API send a 200 even if the URL isn't good.
+	// Unfortunately, it means checking the content of the entry…
+	if isContentFailed(&entry) {
+		m.Dialog.Message = "Wallabag couldn't retrieve content, empty entry created.\nReload it later with R."
+		return nil
+	}
+
+	// Letting user know:
+	m.UpdateMessage = "Entry has been added successfully"
+	return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+		return wallabagoResponseClearMsg(true)
+	})
+}
+
 // Manage update message for updated entry via API.
 func updatedEntryInModel(m *model, updatedEntry wallabago.Item) {
 	// Add a message update. No need for a popup here.
@@ -474,68 +1414,104 @@ func updatedEntryInModel(m *model, updatedEntry wallabago.Item) {
 	// The entry in the model needs to be updated to avoid refreshing all via API
 	m.Entries[getSelectedEntryIndex(m.Entries, updatedEntry.ID)] = updatedEntry
 	// Update the table rows so that's it udpated in the list view:
-	m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
+	m.refreshTableRows()
+}
+
+// Manage reloaded entries via API.
+func reloadedEntriesInModel(m *model, msg wallabagoResponseReloadEntriesMsg) {
+	for _, entry := range msg.Entries {
+		if index := getSelectedEntryIndex(m.Entries, entry.ID); index >= 0 {
+			m.Entries[index] = entry
+		}
+		// Refresh content if the entry is being read:
+		if entry.ID == m.SelectedID {
+			m.setDetailContent(m.SelectedID)
+		}
+	}
+	m.refreshTableRows()
+
+	reloaded, stillFailed := 0, len(msg.errs)
+	for i := range msg.Entries {
+		if isContentFailed(&msg.Entries[i]) {
+			stillFailed++
+		} else {
+			reloaded++
+		}
+	}
+	m.UpdateMessage = "Content reloaded for " + strconv.Itoa(reloaded) + " entries"
+	if stillFailed > 0 {
+		m.UpdateMessage += ", " + strconv.Itoa(stillFailed) + " still without content"
+	}
 }
 
 // Manage keybinds changing filters on listView.
 func listViewFiltersUpdate(msg string, m *model) {
-	if msg == "u" {
+	if msg == "filterUnread" {
 		m.Options.Filters.Unread = !m.Options.Filters.Unread
 		// Unread and Archived can't be selected at the same time:
 		if m.Options.Filters.Unread {
 			m.Options.Filters.Archived = false
 		}
-	} else if msg == "a" {
+	} else if msg == "filterArchived" {
 		m.Options.Filters.Archived = !m.Options.Filters.Archived
 		// Unread and Archived can't be selected at the same time:
 		if m.Options.Filters.Archived {
 			m.Options.Filters.Unread = false
 		}
-	} else if msg == "s" {
+	} else if msg == "filterStarred" {
 		m.Options.Filters.Starred = !m.Options.Filters.Starred
-	} else if msg == "p" {
+	} else if msg == "filterPublic" {
 		m.Options.Filters.Public = !m.Options.Filters.Public
+	} else if msg == "filterFailed" {
+		m.Options.Filters.Failed = !m.Options.Filters.Failed
+	} else if msg == "filterShort" {
+		m.Options.Filters.Short = !m.Options.Filters.Short
+		// Short and Long can't be selected at the same time:
+		if m.Options.Filters.Short {
+			m.Options.Filters.Long = false
+		}
+	} else if msg == "filterLong" {
+		m.Options.Filters.Long = !m.Options.Filters.Long
+		// Short and Long can't be selected at the same time:
+		if m.Options.Filters.Long {
+			m.Options.Filters.Short = false
+		}
 	}
 
-	m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
+	m.refreshTableRows()
 }
 
-// Retrieve updates variable.
-func sendEntryUpdate(msg string, sID int, m *model) (int, int, int, string) {
+// Retrieve the field to update when toggling an entry status.
+func sendEntryUpdate(msg string, sID int, m *model) (map[string]string, string) {
 	entry := m.Entries[getSelectedEntryIndex(m.Entries, sID)]
 	action := "Toggled entry status: "
-	a := entry.IsArchived
-	s := entry.IsStarred
-	p := 0
-	if entry.IsPublic {
-		p = 1
-	}
+	fields := map[string]string{}
 
-	if msg == "A" {
+	if msg == "toggleArchive" {
 		if entry.IsArchived == 0 {
 			action = "archive"
-			a = 1
+			fields["archive"] = "1"
 		} else {
 			action = "read"
-			a = 0
+			fields["archive"] = "0"
 		}
-	} else if msg == "S" {
+	} else if msg == "toggleStarred" {
 		if entry.IsStarred == 0 {
 			action = "starred"
-			s = 1
+			fields["starred"] = "1"
 		} else {
 			action = "unstarred"
-			s = 0
+			fields["starred"] = "0"
 		}
-	} else if msg == "P" {
+	} else if msg == "togglePublic" {
 		if !entry.IsPublic {
 			action = "publish"
-			p = 1
+			fields["public"] = "1"
 		} else {
 			action = "unpublish"
-			p = 0
+			fields["public"] = "0"
 		}
 	}
 
-	return a, s, p, action
+	return fields, action
 }
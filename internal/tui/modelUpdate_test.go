@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"testing"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+	"github.com/Strubbl/wallabago/v7"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestAddFormErrorDialog(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{})
+	m.CurrentView = "add"
+	m.AddForm = newAddForm()
+	m.AddForm.Inputs[addFormURL].SetValue("not a url")
+
+	// Submitting shows the error, confirming it goes back to the form:
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Dialog.Message == "" {
+		t.Fatalf("expected an invalid URL error dialog")
+	}
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.Dialog.Message != "" || m.CurrentView != "add" {
+		t.Errorf("expected the add form, got view %q with dialog %q", m.CurrentView, m.Dialog.Message)
+	}
+	if value := m.AddForm.Inputs[addFormURL].Value(); value != "not a url" {
+		t.Errorf("expected the typed URL to be kept, got %q", value)
+	}
+}
+
+func TestEditFormErrorDialog(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{})
+	m.Entries = []wallabago.Item{{ID: 1, Title: "Go", URL: "https://go.dev"}}
+	m.SelectedID = 1
+	m.CurrentView = "edit"
+	m.EditForm = newEditForm(&m.Entries[0], nil)
+	m.EditForm.Inputs[editFormPublishedAt].SetValue("yesterday")
+
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Dialog.Message == "" {
+		t.Fatalf("expected an invalid date error dialog")
+	}
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.Dialog.Message != "" || m.CurrentView != "edit" {
+		t.Errorf("expected the edit form, got view %q with dialog %q", m.CurrentView, m.Dialog.Message)
+	}
+	if value := m.EditForm.Inputs[editFormPublishedAt].Value(); value != "yesterday" {
+		t.Errorf("expected the typed date to be kept, got %q", value)
+	}
+}
+
+// Update a model, whatever the type of model returned.
+func updateModel(m model, msg tea.Msg) model {
+	updated, _ := m.Update(msg)
+	if p, ok := updated.(*model); ok {
+		return *p
+	}
+
+	return updated.(model)
+}
+
+func TestAddFormClipboardURL(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{})
+	m.CurrentView = "add"
+	m.AddForm = newAddForm()
+
+	// The URL copied is read outside Update, and pre-fills the form when received:
+	m = updateModel(m, walgotClipboardURLMsg("https://go.dev"))
+	if value := m.AddForm.Inputs[addFormURL].Value(); value != "https://go.dev" {
+		t.Errorf("expected the copied URL, got %q", value)
+	}
+
+	// A URL typed meanwhile is kept:
+	m.AddForm.Inputs[addFormURL].SetValue("https://example.org")
+	m = updateModel(m, walgotClipboardURLMsg("https://go.dev"))
+	if value := m.AddForm.Inputs[addFormURL].Value(); value != "https://example.org" {
+		t.Errorf("expected the typed URL to be kept, got %q", value)
+	}
+}
+
+func TestWatchClipboard(t *testing.T) {
+	var tests = []struct {
+		view        string
+		lastContent string
+		expected    bool
+	}{
+		{"list", "", true},
+		{"detail", "", true},
+		// Not while typing or in overlays:
+		{"palette", "", false},
+		{"edit", "", false},
+		{"toc", "", false},
+		{"share", "", false},
+		// Copied by walgot:
+		{"list", "https://example.org/new", false},
+	}
+
+	for _, test := range tests {
+		m, _ := NewModel(config.WalgotConfig{ClipboardWatcher: true})
+		m.Reloading = false
+		m.Clipboard.Initialized = true
+		m.Clipboard.LastContent = test.lastContent
+		m.CurrentView = test.view
+
+		watchClipboard(&m, "https://example.org/new")
+		if offered := m.Dialog.Action == "save url"; offered != test.expected {
+			t.Errorf("watchClipboard on %v (last content %q): expected offered %v, got %v", test.view, test.lastContent, test.expected, offered)
+		}
+	}
+}
+
+func TestEditFormFieldNavigation(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{})
+	m.Entries = []wallabago.Item{{ID: 1, Title: "Go", URL: "https://go.dev"}}
+	m.SelectedID = 1
+	m.CurrentView = "edit"
+	m.EditForm = newEditForm(&m.Entries[0], nil)
+
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyDown})
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyDown})
+	if m.EditForm.Focus != editFormLanguage {
+		t.Errorf("expected down to focus the language field, got field %v", m.EditForm.Focus)
+	}
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyUp})
+	if m.EditForm.Focus != editFormTags {
+		t.Errorf("expected up to focus the tags field, got field %v", m.EditForm.Focus)
+	}
+}
+
+func TestListActionsWithoutEntries(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{})
+	m.Reloading = false
+	m.TermSize = termSize{140, 40}
+	m.SplitView = true
+	windowSizeUpdate(&m)
+
+	// The filtered list is empty, actions on the selected entry do nothing:
+	for _, action := range []string{"select", "switchPane", "toggleArchive", "toggleStarred", "togglePublic", "open", "yank", "delete", "reloadEntry", "filterDomain"} {
+		m = updateModel(m, walgotActionMsg{Action: action})
+		if m.CurrentView != "list" || m.Dialog.Message != "" {
+			t.Errorf("%v: expected the list, got view %q with dialog %q", action, m.CurrentView, m.Dialog.Message)
+		}
+	}
+
+	// Same on the empty domains and views lists:
+	for _, view := range []string{"domains", "views"} {
+		m.CurrentView = view
+		m = updateModel(m, tea.KeyMsg{Type: tea.KeyEnter})
+		if m.CurrentView != view {
+			t.Errorf("select on %v: expected the same view, got %q", view, m.CurrentView)
+		}
+	}
+}
+
+func TestImagesList(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{ImageViewer: "true"})
+	m.Reloading = false
+	m.Entries = []wallabago.Item{{ID: 1, Title: "Pets", Content: `<p><img src="https://example.org/cat.png" alt="A cat"><img src="https://example.org/dog.png" alt="A dog"></p>`}}
+	m.SelectedID = 1
+	m.CurrentView = "detail"
+
+	m = updateModel(m, walgotActionMsg{Action: "images"})
+	if m.CurrentView != "images" || len(m.ImageList.Results) != 2 {
+		t.Fatalf("expected the images list, got view %q with %v", m.CurrentView, m.ImageList.Results)
+	}
+
+	// The selected image is opened with the viewer, started in the background:
+	m = updateModel(m, tea.KeyMsg{Type: tea.KeyDown})
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = *updated.(*model)
+	if m.CurrentView != "detail" || cmd == nil {
+		t.Fatalf("expected the image to be opened, got view %q", m.CurrentView)
+	}
+	if msg := cmd(); msg != (walgotImageViewerMsg{}) {
+		t.Errorf("expected the viewer to start, got %v", msg)
+	}
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/Strubbl/wallabago/v7"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
 	"github.com/muesli/reflow/wordwrap"
 )
 
@@ -28,21 +30,20 @@ func (m model) headerView() string {
 		subtitle += " - Reloading"
 	} else if m.SelectedID > 0 {
 		subtitle += " - Reading"
+	} else if m.CurrentView == "domains" {
+		subtitle += " - Domains"
+	} else if m.CurrentView == "views" {
+		subtitle += " - Views"
+	} else if m.CurrentView == "finder" {
+		subtitle += " - Find"
+	} else if m.CurrentView == "palette" {
+		subtitle += " - Command"
 	} else {
-		if m.Options.Filters.Search != "" {
-			subtitle += " - Searching for " + m.Options.Filters.Search
+		if name := getActiveSavedView(m.SavedViews, m.Options); name != "" {
+			subtitle += " - View " + name
 		}
-		if m.Options.Filters.Unread {
-			subtitle += " - Unread"
-		}
-		if m.Options.Filters.Starred {
-			subtitle += " - Starred"
-		}
-		if m.Options.Filters.Archived {
-			subtitle += " - Archived"
-		}
-		if m.Options.Filters.Public {
-			subtitle += " - Public"
+		for _, filter := range getFiltersDescription(m.Options.Filters) {
+			subtitle += " - " + filter
 		}
 		if len(subtitle) == 0 && !m.Reloading {
 			subtitle = " - All"
@@ -57,6 +58,43 @@ func (m model) headerView() string {
 	return titleStyle.Render(t)
 }
 
+// Retrieve the description of active filters.
+func getFiltersDescription(filters walgotTableFilters) []string {
+	var description []string
+	if filters.Search != "" {
+		description = append(description, "Searching for "+filters.Search)
+	}
+	if filters.Unread {
+		description = append(description, "Unread")
+	}
+	if filters.Starred {
+		description = append(description, "Starred")
+	}
+	if filters.Archived {
+		description = append(description, "Archived")
+	}
+	if filters.Public {
+		description = append(description, "Public")
+	}
+	if filters.Failed {
+		description = append(description, "Without content")
+	}
+	if len(filters.Tags) > 0 {
+		description = append(description, "Tags "+strings.Join(filters.Tags, ", "))
+	}
+	if filters.Domain != "" {
+		description = append(description, "Domain "+filters.Domain)
+	}
+	if filters.Short {
+		description = append(description, "Under "+strconv.Itoa(shortReadingTime)+" min")
+	}
+	if filters.Long {
+		description = append(description, "Over "+strconv.Itoa(longReadingTime)+" min")
+	}
+
+	return description
+}
+
 // Return the footer part of the view.
 func (m model) footerView() string {
 	var text string
@@ -74,10 +112,19 @@ func (m model) footerView() string {
 			Bold(true).
 			Render(strconv.Itoa(m.TotalEntriesOnServer))
 		text += " articles loaded from wallabag"
+
+		nbDisplayed, readingTime := getDisplayedReadingTime(m.Entries, m.Options.Filters)
+		text += " -- " + lipgloss.NewStyle().Bold(true).Render(strconv.Itoa(nbDisplayed)) +
+			" displayed, " + lipgloss.NewStyle().Bold(true).Render(formatReadingTime(readingTime)) +
+			" of reading"
 	}
 
 	if m.TermSize.Width > 80 {
-		text += "\n[r]eload -- Toggles: [u]nread, [s]tarred, [a]rchived -- [h]elp"
+		text += "\n" + m.KeyMap.helpKey("list", "reload") + ": reload -- Toggles: " +
+			m.KeyMap.helpKey("list", "filterUnread") + ": unread, " +
+			m.KeyMap.helpKey("list", "filterStarred") + ": starred, " +
+			m.KeyMap.helpKey("list", "filterArchived") + ": archived -- " +
+			m.KeyMap.helpKey("global", "help") + ": help"
 	}
 
 	return lipgloss.
@@ -100,13 +147,35 @@ func (m model) mainView() string {
 		return reloadingView(m)
 	}
 
-	// Priority: dialog > help > detail > list.
+	// Priority: dialog > help > domains > views > finder > palette > links > toc > share > forms > detail > list.
 	if m.Dialog.Message != "" {
 		return dialogView(&m)
 	} else if m.CurrentView == "help" {
 		return helpView(m)
+	} else if m.CurrentView == "domains" {
+		return domainsView(m)
+	} else if m.CurrentView == "views" {
+		return savedViewsView(m)
+	} else if m.CurrentView == "finder" {
+		return finderView(&m)
+	} else if m.CurrentView == "palette" {
+		return paletteView(&m)
+	} else if m.CurrentView == "links" {
+		return linksView(&m)
+	} else if m.CurrentView == "images" {
+		return imagesView(&m)
+	} else if m.CurrentView == "toc" {
+		return tocView(&m)
+	} else if m.CurrentView == "share" {
+		return shareView(&m)
+	} else if m.CurrentView == "add" {
+		return addFormView(&m)
+	} else if m.CurrentView == "edit" {
+		return editFormView(&m)
+	} else if m.isSplitView() {
+		return splitView(m)
 	} else if m.SelectedID > 0 {
-		return entryDetailView(m)
+		return entryDetailView(m, m.SelectedID, m.TermSize.Width)
 	}
 	return listView(m)
 }
@@ -114,23 +183,53 @@ func (m model) mainView() string {
 // Manage window size changes.
 func windowSizeUpdate(m *model) {
 	h := m.TermSize.Height - lipgloss.Height(m.headerView()) - lipgloss.Height(m.footerView())
+	listWidth, entryWidth := m.getPanesWidth()
+	// Generate viewport based on screen size, in a bordered pane in split view:
+	viewportHeight := h - 5
+	if m.isSplitView() {
+		entryWidth -= 2
+		viewportHeight -= 2
+	}
+	_, contentWidth := m.Reader.getWidths(entryWidth)
+	v := viewport.New(contentWidth, viewportHeight)
+	// Keys are managed by walgot keymap:
+	v.KeyMap = viewport.KeyMap{}
+	// Saving viewport in model:
+	m.Viewport = v
+	m.PreviewID = 0
+
 	// Regenerate the table based on new size:
-	t := createViewTable(m.TermSize.Width, h-5)
+	m.Table = createViewTable(getVisibleColumns(m.ListColumns, listWidth), h-5, m.Theme)
+	m.DomainTable = createViewTable(getDomainColumns(m.TermSize.Width), h-5, m.Theme)
+	m.ViewTable = createViewTable(getSavedViewColumns(m.TermSize.Width), h-5, m.Theme)
+	m.ViewTable.SetRows(getSavedViewTableRows(m.SavedViews))
 	if m.Ready {
-		m.Table.SetRows(getTableRows(m.Entries, m.Options.Filters, m.TermSize.Width))
+		m.refreshTableRows()
+		m.DomainTable.SetRows(getDomainTableRows(getDomains(m.Entries)))
 	}
-	m.Table = t
-	// Generate viewport based on screen size
-	contentWidth := 80
-	if m.TermSize.Width < 80 {
-		contentWidth = m.TermSize.Width
+	// Content of the entry being read needs to fit the new viewport:
+	if m.SelectedID > 0 {
+		m.setDetailContent(m.SelectedID)
 	}
-	v := viewport.New(contentWidth, h-5)
 
 	// We recieved terminal size, we are ready:
 	m.Ready = true
-	// Saving viewport in model:
-	m.Viewport = v
+}
+
+// Check if the list and the selected entry are displayed side by side.
+func (m model) isSplitView() bool {
+	return m.SplitView && m.TermSize.Width >= splitViewMinWidth
+}
+
+// Retrieve the width of the list and of the entry,
+// side by side in split view or full width otherwise.
+func (m model) getPanesWidth() (int, int) {
+	if !m.isSplitView() {
+		return m.TermSize.Width, m.TermSize.Width
+	}
+	listWidth := m.TermSize.Width * 2 / 5
+
+	return listWidth, m.TermSize.Width - listWidth
 }
 
 // Manage reloading view.
@@ -152,81 +251,99 @@ func reloadingView(m model) string {
 
 // Help view.
 func helpView(m model) string {
-	text := []byte(`Help:
-  On all screens:
-  - ctrl+c: Quit
-  - h: Help (this page)
-
-  On listing page:
-  - r: Reload article from wallabag via APIs, takes time depending on the number of articles saved
-  - u: Toggle display only unread articles (disable archived filter)
-  - s: Toggle display only starred articles
-  - a: Toggle archived only articles (disable unread filter)
-  - p: Toggle public only articles (articles with a public link)
-  - A: Toggle Archive / Unread for the current article (and update wallabag backend)
-  - S: Toggle Starred / Unstarred for the current article (and update wallabag backend)
-  - P: Toggle Public status - Public means article can be shared with a public link
-  - O: Open article public link url in default browser. If article isn't public, it will open the original article link.
-  - Y: Yank (copy) URL to clipboard. If article isn't public, it will open the original article link.
-  - /: Open search box
-  - N: Add a new url to wallabag.
-  - D: Delete the selected entry.
-  - esc: Clean search filter, if any
-  - h: Display help
-  - ↑ or k / ↓ or j: Move up / down one item in the list
-  - page down / page up: Move up / down 10 items in the list
-  - home: Go to the top of the list
-  - end: Go to bottom of the list
-  - enter: Select entry to read content
-  - q: Remove search filter if any, otherwise quit
-
-  On detail page:
-  - A: Toggle Archive / Unread for the current article (and update wallabag backend)
-  - S: Toggle Starred / Unstarred for the current article (and update wallabag backend)
-  - P: Toggle Public status - Public means article can be shared with a public link
-  - O: Open article public link url in default browser. If article isn't public, it will open the original article link.
-  - Y: Yank (copy) URL to clipboard. If article isn't public, it will open the original article link.
-  - L: Open link within content. Give a link number as displayed in footnotes of the article.
-  - D: Delete the selected entry.
-  - q: Return to list
-  - ↑ or k / ↓ or j: Go up / down
-
-  On any dialog (modal) view:
-  - "esc": Close the dialog
-
-  On search modal view:
-  - "enter": start search
-
-
-  On help page:
-  - q, esc: Return to list
-
-  Status explanation:
-  - ⭐ Starred article
-  - 🆕 Unread article
-  - 🔗 Article with a public shareable link
-`)
+	text := "Help:\n"
+	for _, view := range keyMapViews {
+		text += "  " + view.Title + ":\n"
+		for _, b := range m.KeyMap.Bindings {
+			if b.View != view.Name || !b.Binding.Enabled() {
+				continue
+			}
+			keys := b.Binding.Help().Key
+			if keys == " " {
+				keys = "space"
+			}
+			text += "  - " + keys + ": " + b.Binding.Help().Desc + "\n"
+		}
+		text += "\n"
+	}
+
+	text += "  Status explanation:\n" +
+		"  - " + m.Theme.Status.Starred + " Starred article\n" +
+		"  - " + m.Theme.Status.Unread + " Unread article\n" +
+		"  - " + m.Theme.Status.Public + " Article with a public shareable link\n"
 
 	return lipgloss.
 		NewStyle().
 		Width(m.TermSize.Width).
 		Align(lipgloss.Left).
-		Render(string(text))
+		Render(text)
 }
 
 // Get article detail view.
-func entryDetailView(m model) string {
-	i := getSelectedEntryIndex(m.Entries, m.SelectedID)
-	header := entryDetailViewTitle(&m.Entries[i], m.TermSize.Width)
-	footer := entryDetailViewFooter(m.Viewport, &m.Entries[i])
+func entryDetailView(m model, entryID, width int) string {
+	i := getSelectedEntryIndex(m.Entries, entryID)
+	header := entryDetailViewTitle(&m.Entries[i], width)
+	// Reading aloud, search matches and focused link are only displayed for the entry read:
+	var info []string
+	if m.Speech.Current > 0 && entryID == m.SelectedID {
+		info = append(info, getSpeechInfo(m.Speech))
+	}
+	if m.ContentSearch.Term != "" && entryID == m.SelectedID {
+		info = append(info, getContentSearchInfo(m.ContentSearch))
+	}
+	if m.LinkFocus > 0 && entryID == m.SelectedID {
+		if m.LinkFocus <= len(m.Links) {
+			info = append(info, "["+strconv.Itoa(m.LinkFocus)+"] "+m.Links[m.LinkFocus-1].URL)
+		}
+	}
+	section := ""
+	if current := m.getReadHeading(); current >= 0 {
+		section = m.Headings[current].Text
+	}
+	footer := entryDetailViewFooter(m.Viewport, &m.Entries[i], m.Theme.Status, strings.Join(info, " - "), section)
 
 	return lipgloss.
 		NewStyle().
-		Width(m.TermSize.Width).
+		Width(width).
 		Align(lipgloss.Center).
 		Render(header + "\n" + m.Viewport.View() + "\n" + footer)
 }
 
+// Split view, with the list on the left and the entry read or previewed on the right.
+// The focused pane is the entry one when an entry is read.
+func splitView(m model) string {
+	listWidth, entryWidth := m.getPanesWidth()
+	// The entry pane is bordered:
+	entryWidth -= 2
+
+	entryID := m.SelectedID
+	if entryID == 0 {
+		entryID = m.PreviewID
+	}
+	entry := "No article selected"
+	if getSelectedEntryIndex(m.Entries, entryID) >= 0 {
+		entry = entryDetailView(m, entryID, entryWidth)
+	}
+
+	borderColor := m.Theme.Border
+	if m.SelectedID > 0 {
+		borderColor = m.Theme.Accent
+	}
+	entryPane := lipgloss.
+		NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(entryWidth).
+		Render(entry)
+
+	listPane := lipgloss.
+		NewStyle().
+		Width(listWidth).
+		Render(m.Table.View())
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, listPane, entryPane)
+}
+
 // Retrieve title for detail view.
 func entryDetailViewTitle(entry *wallabago.Item, maxWidth int) string {
 	w := 80
@@ -246,18 +363,10 @@ func entryDetailViewTitle(entry *wallabago.Item, maxWidth int) string {
 		Render(title)
 }
 
-// Retrieve footer for detail view.
-func entryDetailViewFooter(viewport viewport.Model, entry *wallabago.Item) string {
-	status := ""
-	if entry.IsArchived == 0 {
-		status += "🆕"
-	}
-	if entry.IsStarred == 1 {
-		status += "⭐"
-	}
-	if entry.IsPublic {
-		status += "🔗"
-	}
+// Retrieve footer for detail view, with optional information (eg: search matches or the focused link)
+// and the section being read.
+func entryDetailViewFooter(viewport viewport.Model, entry *wallabago.Item, symbols walgotStatusSymbols, info, section string) string {
+	status := getEntryStatus(entry, symbols, false)
 
 	statusInfo := lipgloss.
 		NewStyle().
@@ -273,13 +382,36 @@ func entryDetailViewFooter(viewport viewport.Model, entry *wallabago.Item) strin
 		BorderRight(true).
 		Render(fmt.Sprintf("%3.f%%", viewport.ScrollPercent()*100))
 
+	// Section is displayed next to the reading percentage, taking up to a third of the width:
+	if section != "" && viewport.Width/3 > 5 {
+		section = lipgloss.
+			NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderLeft(true).
+			Render(truncate.StringWithTail(section, uint(viewport.Width/3-1), "…"))
+		readInfo = lipgloss.JoinHorizontal(lipgloss.Center, section, readInfo)
+	}
+
 	width := viewport.Width - lipgloss.Width(readInfo) - lipgloss.Width(statusInfo)
+	if info != "" && width > 10 {
+		// Information takes the available width, keeping some line around:
+		info = lipgloss.
+			NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderLeft(true).
+			BorderRight(true).
+			Render(truncate.StringWithTail(info, uint(width-6), "…"))
+		width -= lipgloss.Width(info)
+	} else {
+		info = ""
+	}
 	if width < 0 {
 		width = 0
 	}
-	line := strings.Repeat("─", width)
+	// Information is centered:
+	before, after := strings.Repeat("─", width/2), strings.Repeat("─", width-width/2)
 
-	return lipgloss.JoinHorizontal(lipgloss.Center, statusInfo, line, readInfo)
+	return lipgloss.JoinHorizontal(lipgloss.Center, statusInfo, before, info, after, readInfo)
 }
 
 // Get list view.
@@ -287,11 +419,280 @@ func listView(m model) string {
 	return m.Table.View()
 }
 
+// Domains view.
+func domainsView(m model) string {
+	return m.DomainTable.View()
+}
+
+// Saved views view.
+func savedViewsView(m model) string {
+	return m.ViewTable.View()
+}
+
+// Fuzzy finder view.
+func finderView(m *model) string {
+	finder := &m.Finder
+	width := m.TermSize.Width - 4
+	maxResults := m.TermSize.Height - lipgloss.Height(m.headerView()) - lipgloss.Height(m.footerView()) - 7
+	if maxResults < 1 {
+		maxResults = 1
+	}
+	// Scroll results to keep the cursor visible:
+	first := 0
+	if finder.Cursor >= maxResults {
+		first = finder.Cursor - maxResults + 1
+	}
+
+	highlight := lipgloss.NewStyle().Foreground(m.Theme.Accent).Bold(true)
+	if m.Theme.NoColor {
+		highlight = highlight.Underline(true)
+	}
+	faint := lipgloss.NewStyle().Faint(true)
+
+	finder.Input.PromptStyle = lipgloss.NewStyle().Foreground(m.Theme.Accent)
+	lines := []string{
+		finder.Input.View(),
+		faint.Render(strconv.Itoa(len(finder.Results)) + "/" + strconv.Itoa(len(m.Entries)) + " articles"),
+	}
+	for i := first; i < len(finder.Results) && i < first+maxResults; i++ {
+		result := finder.Results[i]
+		entry := &m.Entries[result.Index]
+
+		line := "  "
+		if i == finder.Cursor {
+			line = highlight.Render("> ")
+		}
+		line += getEntryStatus(entry, m.Theme.Status, true) + " " +
+			highlightPositions(entry.Title, result.TitlePositions, highlight)
+		if entry.DomainName != "" {
+			line += faint.Render(" - ") + highlightPositions(entry.DomainName, result.DomainPositions, highlight)
+		}
+		if tags := getEntryTagsText(entry); tags != "" {
+			line += faint.Render(" [") + highlightPositions(tags, result.TagsPositions, highlight) + faint.Render("]")
+		}
+		lines = append(lines, truncate.StringWithTail(line, uint(width-2), "…"))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Command palette view.
+func paletteView(m *model) string {
+	palette := &m.Palette
+	width := m.TermSize.Width - 4
+	maxSuggestions := m.TermSize.Height - lipgloss.Height(m.headerView()) - lipgloss.Height(m.footerView()) - 7
+	if maxSuggestions < 1 {
+		maxSuggestions = 1
+	}
+	// Scroll suggestions to keep the cursor visible:
+	first := 0
+	if palette.Cursor >= maxSuggestions {
+		first = palette.Cursor - maxSuggestions + 1
+	}
+
+	highlight := lipgloss.NewStyle().Foreground(m.Theme.Accent).Bold(true)
+	if m.Theme.NoColor {
+		highlight = highlight.Underline(true)
+	}
+	faint := lipgloss.NewStyle().Faint(true)
+
+	palette.Input.PromptStyle = lipgloss.NewStyle().Foreground(m.Theme.Accent)
+	info := faint.Render(strconv.Itoa(len(palette.Suggestions)) + " commands")
+	if palette.Error != "" {
+		info = lipgloss.NewStyle().Bold(true).Render(palette.Error)
+	} else if len(palette.Suggestions) > 0 && palette.Suggestions[palette.Cursor].NeedsArgs {
+		info = faint.Render("Usage: " + palette.Suggestions[palette.Cursor].Usage)
+	}
+	lines := []string{palette.Input.View(), info}
+
+	for i := first; i < len(palette.Suggestions) && i < first+maxSuggestions; i++ {
+		suggestion := palette.Suggestions[i]
+		line := "  "
+		if i == palette.Cursor {
+			line = highlight.Render("> ")
+		}
+		line += highlightPositions(suggestion.Name, suggestion.Positions, highlight)
+		if !suggestion.NeedsArgs {
+			line += faint.Render(" (" + suggestion.Usage + ")")
+		}
+		line += faint.Render(" - ") + suggestion.Help
+		lines = append(lines, truncate.StringWithTail(line, uint(width-2), "…"))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Links list overlay view.
+func linksView(m *model) string {
+	return linkListView(m, &m.LinkList, "links", m.KeyMap.helpKey("links", "select")+": open, "+
+		m.KeyMap.helpKey("links", "focus")+": focus, "+
+		m.KeyMap.helpKey("links", "copy")+": copy, "+
+		m.KeyMap.helpKey("links", "save")+": save to wallabag")
+}
+
+// Images list overlay view.
+func imagesView(m *model) string {
+	return linkListView(m, &m.ImageList, "images", m.KeyMap.helpKey("images", "select")+": open, "+
+		m.KeyMap.helpKey("images", "copy")+": copy link")
+}
+
+// Filterable list of links or images, with the help of its keys.
+func linkListView(m *model, list *walgotLinkList, name, help string) string {
+	width := m.TermSize.Width - 4
+	maxResults := m.TermSize.Height - lipgloss.Height(m.headerView()) - lipgloss.Height(m.footerView()) - 7
+	if maxResults < 1 {
+		maxResults = 1
+	}
+	// Scroll results to keep the cursor visible:
+	first := 0
+	if list.Cursor >= maxResults {
+		first = list.Cursor - maxResults + 1
+	}
+
+	highlight := lipgloss.NewStyle().Foreground(m.Theme.Accent).Bold(true)
+	if m.Theme.NoColor {
+		highlight = highlight.Underline(true)
+	}
+	faint := lipgloss.NewStyle().Faint(true)
+
+	list.Input.PromptStyle = lipgloss.NewStyle().Foreground(m.Theme.Accent)
+	lines := []string{
+		list.Input.View(),
+		faint.Render(strconv.Itoa(len(list.Results)) + "/" + strconv.Itoa(len(list.Links)) + " " + name + " -- " + help),
+	}
+	for i := first; i < len(list.Results) && i < first+maxResults; i++ {
+		result := list.Results[i]
+		link := list.Links[result.Index]
+
+		line := "  "
+		if i == list.Cursor {
+			line = highlight.Render("> ")
+		}
+		line += faint.Render("[" + strconv.Itoa(result.Index+1) + "] ")
+		if link.Text != "" {
+			line += highlightPositions(link.Text, result.TextPositions, highlight) + faint.Render(" - ")
+		}
+		line += highlightPositions(link.URL, result.URLPositions, highlight)
+		lines = append(lines, truncate.StringWithTail(line, uint(width-2), "…"))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Table of contents overlay, headings being indented by level.
+func tocView(m *model) string {
+	width := m.TermSize.Width - 4
+	maxHeadings := m.TermSize.Height - lipgloss.Height(m.headerView()) - lipgloss.Height(m.footerView()) - 6
+	if maxHeadings < 1 {
+		maxHeadings = 1
+	}
+	// Scroll headings to keep the cursor visible:
+	first := 0
+	if m.TOCCursor >= maxHeadings {
+		first = m.TOCCursor - maxHeadings + 1
+	}
+
+	highlight := lipgloss.NewStyle().Foreground(m.Theme.Accent).Bold(true)
+	if m.Theme.NoColor {
+		highlight = highlight.Underline(true)
+	}
+	faint := lipgloss.NewStyle().Faint(true)
+
+	lines := []string{
+		faint.Render("Table of contents -- " + m.KeyMap.helpKey("toc", "select") + ": go to section, " +
+			m.KeyMap.helpKey("toc", "close") + ": close"),
+	}
+	for i := first; i < len(m.Headings) && i < first+maxHeadings; i++ {
+		heading := m.Headings[i]
+
+		line := "  "
+		text := strings.Repeat("  ", heading.Level-1) + heading.Text
+		if i == m.TOCCursor {
+			line = highlight.Render("> ")
+			text = highlight.Render(text)
+		}
+		lines = append(lines, truncate.StringWithTail(line+text, uint(width-2), "…"))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Share menu overlay, for the selected entry.
+func shareView(m *model) string {
+	width := m.TermSize.Width - 4
+	highlight := lipgloss.NewStyle().Foreground(m.Theme.Accent).Bold(true)
+	if m.Theme.NoColor {
+		highlight = highlight.Underline(true)
+	}
+	faint := lipgloss.NewStyle().Faint(true)
+
+	title := "…"
+	if index := getSelectedEntryIndex(m.Entries, m.Share.EntryID); index >= 0 {
+		title = m.Entries[index].Title
+	}
+	lines := []string{
+		truncate.StringWithTail(faint.Render("Share \""+title+"\" -- "+m.KeyMap.helpKey("share", "select")+": share, "+
+			m.KeyMap.helpKey("share", "close")+": close"), uint(width-2), "…"),
+	}
+	for i, option := range shareOptions {
+		line := "  " + option.Text
+		if i == m.Share.Cursor {
+			line = highlight.Render("> " + option.Text)
+		}
+		lines = append(lines, line)
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Highlight characters of a text at the given positions (rune index).
+func highlightPositions(text string, positions []int, style lipgloss.Style) string {
+	if len(positions) == 0 {
+		return text
+	}
+
+	highlighted := map[int]bool{}
+	for _, p := range positions {
+		highlighted[p] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(text) {
+		if highlighted[i] {
+			b.WriteString(style.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
 // Get dialog view.
 func dialogView(m *model) string {
 	dialogBoxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#874BFD")).
+		BorderForeground(m.Theme.Border).
 		Padding(1, 0).
 		BorderTop(true).
 		BorderLeft(true).
@@ -299,11 +700,16 @@ func dialogView(m *model) string {
 		BorderBottom(true)
 
 	actionButton := ""
-	if m.Dialog.Action == "search" || m.Dialog.Action == "add" || m.Dialog.Action == "open link" {
+	if m.Dialog.Action == "search" || m.Dialog.Action == "duplicate" || m.Dialog.Action == "save url" || m.Dialog.Action == "save link" || m.Dialog.Action == "save view" {
 		text := strings.Title(m.Dialog.Action) + " (Enter)"
+		if m.Dialog.Action == "duplicate" {
+			text = "Open existing (Enter)"
+		} else if m.Dialog.Action == "save url" || m.Dialog.Action == "save link" || m.Dialog.Action == "save view" {
+			text = "Save (Enter)"
+		}
 		actionButton = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFF7DB")).
-			Background(lipgloss.Color("#888B7E")).
+			Foreground(m.Theme.ButtonFg).
+			Background(m.Theme.ButtonBg).
 			Padding(0, 3).
 			MarginTop(1).
 			Underline(true).
@@ -311,8 +717,8 @@ func dialogView(m *model) string {
 	}
 
 	closeButton := lipgloss.NewStyle().
-		Background(lipgloss.Color("#FFF7DB")).
-		Foreground(lipgloss.Color("#888B7E")).
+		Background(m.Theme.ButtonFg).
+		Foreground(m.Theme.ButtonBg).
 		Padding(0, 3).
 		MarginTop(1).
 		Underline(true).
@@ -333,7 +739,7 @@ func dialogView(m *model) string {
 	if m.Dialog.ShowInput {
 		m.Dialog.TextInput.PromptStyle = lipgloss.
 			NewStyle().
-			Foreground(lipgloss.Color("205")).
+			Foreground(m.Theme.Accent).
 			Align(lipgloss.Left)
 		content = lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -351,111 +757,197 @@ func dialogView(m *model) string {
 		Render(dialogBoxStyle.Render(ui))
 }
 
-// ** Table related functions ** //
-// Create Columns.
-func createViewTableColumns(maxWidth int) []table.Column {
-	baseWidth := int(maxWidth / 20)
-	var columns []table.Column
-
-	if maxWidth > 130 {
-		columns = []table.Column{
-			{Title: "ID", Width: baseWidth},
-			{Title: "Status", Width: baseWidth},
-			{Title: "Title", Width: baseWidth * 12},
-			{Title: "Domain", Width: baseWidth * 4},
-			{Title: "Created", Width: baseWidth * 2},
+// Get add entry form view.
+func addFormView(m *model) string {
+	form := &m.AddForm
+	formBoxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Padding(1, 2)
+	focusedStyle := lipgloss.NewStyle().Foreground(m.Theme.Accent)
+
+	var lines []string
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render("Add a URL to wallabag:"), "")
+	lines = append(lines, formInputsView(form.Inputs, []string{"URL:", "Title:", "Tags:"}, form.Focus, m.Theme)...)
+	// Tag autocompletion hint:
+	if form.Focus == addFormTags {
+		if suggestion := getTagSuggestion(form.Inputs[addFormTags].Value(), form.KnownTags); suggestion != "" {
+			lines = append(lines, lipgloss.NewStyle().Faint(true).Render("          tab: complete with \""+suggestion+"\""))
 		}
-	} else if maxWidth > 80 {
-		columns = []table.Column{
-			{Title: "ID", Width: baseWidth},
-			{Title: "Status", Width: baseWidth},
-			{Title: "Title", Width: baseWidth * 18},
+	}
+
+	checkboxes := []struct {
+		field   int
+		label   string
+		checked bool
+	}{
+		{addFormArchive, "Archive", form.Archive},
+		{addFormStarred, "Starred", form.Starred},
+	}
+	var boxes []string
+	for _, c := range checkboxes {
+		box := "[ ] "
+		if c.checked {
+			box = "[x] "
 		}
-	} else {
-		columns = []table.Column{
-			{Title: "ID", Width: 0},
-			{Title: "Title", Width: baseWidth * 20},
+		style := lipgloss.NewStyle().MarginRight(3)
+		if form.Focus == c.field {
+			style = style.Inherit(focusedStyle)
 		}
+		boxes = append(boxes, style.Render(box+c.label))
+	}
+	lines = append(lines, "", lipgloss.JoinHorizontal(lipgloss.Top, boxes...), "")
 
+	contentLabel := "Content (optional):"
+	if form.Focus == addFormContent {
+		contentLabel = focusedStyle.Render(contentLabel)
 	}
+	lines = append(lines, contentLabel, form.Content.View())
+
+	lines = append(lines, "", lipgloss.NewStyle().Faint(true).Render(
+		"tab/shift+tab: next/previous field - space: toggle\nenter: add (ctrl+s from content) - esc: close",
+	))
 
-	return columns
+	return lipgloss.
+		NewStyle().
+		Width(m.TermSize.Width).
+		Align(lipgloss.Center).
+		Render(formBoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...)))
 }
 
-// Create rows
-// TODO: create test for this function.
-func getTableRows(items []wallabago.Item, filters walgotTableFilters, maxWidth int) []table.Row {
-	r := []table.Row{}
+// Get edit entry form view.
+func editFormView(m *model) string {
+	form := &m.EditForm
+	formBoxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Padding(1, 2)
 
-	for i := 0; i < len(items); i++ {
-		title := items[i].Title
-		id := strconv.Itoa(items[i].ID)
-		domainName := items[i].DomainName
-		status := "  "
-		createdAt := items[i].CreatedAt.Time.Format("2006-02-01")
-
-		// Public filter:
-		if filters.Public && !items[i].IsPublic {
-			continue
-		}
-		// Unread filter:
-		if filters.Unread && items[i].IsArchived != 0 {
-			continue
-		}
-		// Archived filter:
-		if filters.Archived && items[i].IsArchived != 1 {
-			continue
-		}
-		// Starred filter:
-		if filters.Starred && items[i].IsStarred != 1 {
-			continue
-		}
-		// Search filter:
-		if filters.Search != "" && !containsI(items[i].Title, filters.Search) {
-			continue
+	var lines []string
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render("Edit entry:"), "")
+	lines = append(lines, formInputsView(
+		form.Inputs,
+		[]string{"Title:", "Tags:", "Language:", "Original:", "Date:", "Authors:"},
+		form.Focus,
+		m.Theme,
+	)...)
+	// Tag autocompletion hint:
+	if form.Focus == editFormTags {
+		if suggestion := getTagSuggestion(form.Inputs[editFormTags].Value(), form.KnownTags); suggestion != "" {
+			lines = append(lines, lipgloss.NewStyle().Faint(true).Render("          tab: complete with \""+suggestion+"\""))
 		}
+	}
 
-		archivedEntry := true
-		if items[i].IsArchived == 0 {
-			status = "🆕"
-			archivedEntry = false
-		}
-		if items[i].IsStarred == 1 {
-			status += "⭐"
-		} else {
-			status += "  "
+	lines = append(lines, "", lipgloss.NewStyle().Faint(true).Render(
+		"tab/shift+tab: next/previous field\nenter: save - esc: close",
+	))
+
+	return lipgloss.
+		NewStyle().
+		Width(m.TermSize.Width).
+		Align(lipgloss.Center).
+		Render(formBoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...)))
+}
+
+// Render form text inputs with their labels.
+func formInputsView(inputs []textinput.Model, labels []string, focus int, theme walgotTheme) []string {
+	labelStyle := lipgloss.NewStyle().Width(10).Bold(true)
+	focusedStyle := lipgloss.NewStyle().Foreground(theme.Accent)
+	if theme.NoColor {
+		focusedStyle = focusedStyle.Underline(true)
+	}
+
+	var lines []string
+	for i := range inputs {
+		inputs[i].PromptStyle = focusedStyle
+		label := labelStyle.Render(labels[i])
+		if focus == i {
+			label = labelStyle.Copy().Inherit(focusedStyle).Render(labels[i])
 		}
-		if items[i].IsPublic {
-			status += "🔗"
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, label, inputs[i].View()))
+	}
+
+	return lines
+}
+
+// ** Table related functions ** //
+// Create Columns.
+func createViewTableColumns(columns []walgotColumn) []table.Column {
+	var tableColumns []table.Column
+	for _, c := range columns {
+		tableColumns = append(tableColumns, table.Column{Title: c.Title, Width: c.Width})
+	}
+
+	return tableColumns
+}
+
+// Check if an entry matches the list filters.
+func isEntryDisplayed(entry *wallabago.Item, filters walgotTableFilters) bool {
+	// Public filter:
+	if filters.Public && !entry.IsPublic {
+		return false
+	}
+	// Unread filter:
+	if filters.Unread && entry.IsArchived != 0 {
+		return false
+	}
+	// Archived filter:
+	if filters.Archived && entry.IsArchived != 1 {
+		return false
+	}
+	// Starred filter:
+	if filters.Starred && entry.IsStarred != 1 {
+		return false
+	}
+	// Failed content filter:
+	if filters.Failed && !isContentFailed(entry) {
+		return false
+	}
+	// Short reading time filter, unknown reading times (0) excluded:
+	if filters.Short && (entry.ReadingTime <= 0 || entry.ReadingTime >= shortReadingTime) {
+		return false
+	}
+	// Long reading time filter:
+	if filters.Long && entry.ReadingTime <= longReadingTime {
+		return false
+	}
+	// Tags filter, entries need to have all tags:
+	for _, tag := range filters.Tags {
+		if !hasTag(entry, tag) {
+			return false
 		}
+	}
+	// Domain filter:
+	if filters.Domain != "" && entry.DomainName != filters.Domain {
+		return false
+	}
+	// Search filter:
+	if filters.Search != "" && !containsI(entry.Title, filters.Search) {
+		return false
+	}
+
+	return true
+}
 
-		if archivedEntry {
-			// This create a bug in the selected row,
-			// where it stops the selected style (blue background).
-			// TODO: Create an issue on bubble bugtracker
-			title = lipgloss.NewStyle().Faint(true).Render(title)
+// Create rows, the first column being always the entry ID.
+func getTableRows(items []wallabago.Item, filters walgotTableFilters, columns []walgotColumn, options walgotRowOptions) []table.Row {
+	r := []table.Row{}
+
+	for i := 0; i < len(items); i++ {
+		if !isEntryDisplayed(&items[i], filters) {
+			continue
 		}
 
 		var new table.Row
-		if maxWidth > 130 {
-			new = table.Row{
-				id,
-				status,
-				title,
-				domainName,
-				createdAt,
-			}
-		} else if maxWidth > 80 {
-			new = table.Row{
-				id,
-				status,
-				title,
-			}
-		} else {
-			new = table.Row{
-				id,
-				title,
+		for _, c := range columns {
+			value := getColumnValue(c.Name, &items[i], options)
+			if c.Name == "title" && items[i].IsArchived != 0 {
+				// This create a bug in the selected row,
+				// where it stops the selected style (blue background).
+				// TODO: Create an issue on bubble bugtracker
+				value = lipgloss.NewStyle().Faint(true).Render(value)
 			}
+			new = append(new, value)
 		}
 
 		r = append(r, new)
@@ -464,22 +956,108 @@ func getTableRows(items []wallabago.Item, filters walgotTableFilters, maxWidth i
 	return r
 }
 
+// Retrieve entry status symbols.
+// If aligned, missing status are replaced by spaces so that status are aligned in the list.
+func getEntryStatus(entry *wallabago.Item, symbols walgotStatusSymbols, aligned bool) string {
+	status := ""
+	if entry.IsArchived == 0 {
+		status += symbols.Unread
+	} else if aligned {
+		status += symbols.Empty
+	}
+	if entry.IsStarred == 1 {
+		status += symbols.Starred
+	} else if aligned {
+		status += symbols.Empty
+	}
+	if entry.IsPublic {
+		status += symbols.Public
+	}
+
+	return status
+}
+
+// Columns of the domains table, the first one being always the domain name.
+func getDomainColumns(maxWidth int) []walgotColumn {
+	// Cells have a padding of 1 on each side:
+	domainWidth := maxWidth - 2*(8+2) - 2
+	if domainWidth < flexibleColumnMinWidth {
+		domainWidth = flexibleColumnMinWidth
+	}
+
+	return []walgotColumn{
+		{Name: "domain", Title: "Domain", Width: domainWidth},
+		{Name: "unread", Title: "Unread", Width: 8},
+		{Name: "total", Title: "Total", Width: 8},
+	}
+}
+
+// Create domains table rows.
+func getDomainTableRows(domains []walgotDomain) []table.Row {
+	r := []table.Row{}
+	for _, d := range domains {
+		r = append(r, table.Row{d.Name, strconv.Itoa(d.NbUnread), strconv.Itoa(d.NbTotal)})
+	}
+
+	return r
+}
+
+// Refresh table rows, after entries or filters changes.
+func (m *model) refreshTableRows() {
+	listWidth, _ := m.getPanesWidth()
+	m.Table.SetRows(getTableRows(
+		m.Entries,
+		m.Options.Filters,
+		getVisibleColumns(m.ListColumns, listWidth),
+		walgotRowOptions{
+			Symbols:    m.Theme.Status,
+			DateFormat: m.DateFormat,
+			Progress:   m.ReadingProgress,
+		},
+	))
+	// The entry read stays selected in the list:
+	if position := getDisplayedEntryPosition(m.Entries, m.Options.Filters, m.SelectedID); m.SelectedID > 0 && position >= 0 {
+		m.Table.SetCursor(position)
+	}
+	// Entries may have changed, preview needs to be regenerated:
+	m.PreviewID = 0
+	m.updatePreview()
+}
+
+// Preview the entry selected in the list, in split view.
+func (m *model) updatePreview() {
+	if !m.isSplitView() || m.SelectedID > 0 {
+		return
+	}
+
+	sID := getDisplayedEntryID(m.Entries, m.Options.Filters, m.Table.Cursor())
+	if sID == m.PreviewID {
+		return
+	}
+	m.PreviewID = sID
+	m.setDetailContent(sID)
+	m.Viewport.GotoTop()
+}
+
 // Generate the bubbletea table.
-func createViewTable(maxWidth int, maxHeight int) table.Model {
+func createViewTable(columns []walgotColumn, maxHeight int, theme walgotTheme) table.Model {
 	t := table.New(
-		table.WithColumns(createViewTableColumns(maxWidth)),
+		table.WithColumns(createViewTableColumns(columns)),
 		table.WithHeight(maxHeight),
 	)
 	s := table.DefaultStyles()
 	s.Header = s.Header.
 		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
+		BorderForeground(theme.TableBorder).
 		BorderBottom(true).
 		BorderTop(true).
 		Bold(true)
 	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57"))
+		Foreground(theme.SelectedFg).
+		Background(theme.SelectedBg)
+	if theme.NoColor {
+		s.Selected = s.Selected.Reverse(true)
+	}
 
 	t.SetStyles(s)
 
@@ -487,12 +1065,57 @@ func createViewTable(maxWidth int, maxHeight int) table.Model {
 }
 
 // ** Viewport related functions ** //
-// Generate content for article detail viewport.
-func getDetailViewportContent(selectedID int, entries []wallabago.Item, maxWidth int) string {
+// Options to render entries content in the viewport.
+func (m model) getContentOptions() walgotContentOptions {
+	highlight := lipgloss.NewStyle().Foreground(m.Theme.SelectedFg).Background(m.Theme.SelectedBg)
+	matchHighlight := lipgloss.NewStyle().Foreground(m.Theme.Accent).Underline(true)
+	if m.Theme.NoColor {
+		highlight = highlight.Reverse(true)
+	}
+
+	return walgotContentOptions{
+		Width:            m.Viewport.Width,
+		Reader:           m.Reader,
+		Style:            getContentStyle(m.Theme),
+		Images:           m.Images,
+		Highlight:        highlight,
+		SpeechParagraphs: m.Speech.Paragraphs,
+		SpokenParagraph:  m.Speech.Current,
+		Search:           m.ContentSearch.Term,
+		SearchMatch:      m.ContentSearch.Current,
+		MatchHighlight:   matchHighlight,
+	}
+}
+
+// Generate content for article detail viewport, with its headings and links.
+func getDetailViewportContent(selectedID int, entries []wallabago.Item, options walgotContentOptions) (string, []walgotHeading, []walgotLink) {
 	content := "…"
+	var headings []walgotHeading
+	var links []walgotLink
 	if index := getSelectedEntryIndex(entries, selectedID); index >= 0 {
-		content = getSelectedEntryContent(entries, index, maxWidth)
+		content, headings, links = getSelectedEntryContent(entries, index, options)
+	}
+
+	return content, headings, links
+}
+
+// Display the content of an entry in the viewport, keeping its headings and links for navigation.
+func (m *model) setDetailContent(entryID int) string {
+	content, headings, links := getDetailViewportContent(entryID, m.Entries, m.getContentOptions())
+	m.Content = content
+	m.Headings = headings
+	m.Links = links
+
+	return m.showLinkFocus()
+}
+
+// Display the content in the viewport, with the focused link highlighted.
+func (m *model) showLinkFocus() string {
+	content := m.Content
+	if m.LinkFocus > 0 && m.LinkFocus <= len(m.Links) {
+		content = highlightContentLink(content, m.Links[m.LinkFocus-1], m.LinkFocus, m.getContentOptions().Highlight)
 	}
+	m.Viewport.SetContent(content)
 
 	return content
 }
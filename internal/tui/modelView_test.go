@@ -0,0 +1,26 @@
+package tui
+
+import "testing"
+
+func TestGetPanesWidth(t *testing.T) {
+	var tests = []struct {
+		splitView     bool
+		width         int
+		expectedList  int
+		expectedEntry int
+	}{
+		{false, 150, 150, 150},
+		{true, 150, 60, 90},
+		{true, 121, 48, 73},
+		// Too narrow for split view:
+		{true, 100, 100, 100},
+	}
+
+	for _, test := range tests {
+		m := model{SplitView: test.splitView, TermSize: termSize{Width: test.width, Height: 40}}
+		listWidth, entryWidth := m.getPanesWidth()
+		if listWidth != test.expectedList || entryWidth != test.expectedEntry {
+			t.Errorf("getPanesWidth(%v, %v): expected %v / %v, got %v / %v", test.splitView, test.width, test.expectedList, test.expectedEntry, listWidth, entryWidth)
+		}
+	}
+}
@@ -0,0 +1,377 @@
+package tui
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Strubbl/wallabago/v7"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Maximum number of commands kept in the palette history.
+const paletteHistorySize = 50
+
+// Palette command taking arguments, in addition to keybind actions.
+type walgotCommand struct {
+	Name  string
+	Usage string
+	Help  string
+	// Views the command is available on:
+	Views []string
+}
+
+// Keybind action run from the palette, without pressing its key.
+// Index is the position of the key among the action keys, eg: the view number of selectView.
+type walgotActionMsg struct {
+	Action string
+	Index  int
+}
+
+// Commands taking arguments.
+var paletteCommands = []walgotCommand{
+	{
+		Name:  "tag",
+		Usage: "tag <add|remove> <tags, comma separated>",
+		Help:  "Add or remove tags of the selected article",
+		Views: []string{"list", "detail"},
+	},
+	{
+		Name:  "sort",
+		Usage: "sort <" + strings.Join(availableSortFields, "|") + "> [asc|desc]",
+		Help:  "Sort articles",
+		Views: []string{"list"},
+	},
+	{
+		Name:  "filter",
+		Usage: "filter <unread|starred|archived|public|failed|short|long|domain|tag|search|clear> [value]",
+		Help:  "Toggle a filter, or filter on a domain, tags or title",
+		Views: []string{"list"},
+	},
+	{
+		Name:  "view",
+		Usage: "view <name>",
+		Help:  "Display a saved view",
+		Views: []string{"list"},
+	},
+	{
+		Name:  "reader",
+		Usage: "reader <width|margins|align|spacing|hyphenation> <value> (margins: <left> [right], align: center|left, hyphenation: on|off)",
+		Help:  "Change how articles are displayed",
+		Views: []string{"list", "detail"},
+	},
+	{
+		Name:  "external",
+		Usage: "external <editor|pager> [text|markdown|html]",
+		Help:  "Open the article read in $EDITOR or $PAGER, as text (default), markdown or HTML",
+		Views: []string{"detail"},
+	},
+}
+
+// Filters toggled by the filter command, with their keybind action.
+var paletteToggleFilters = map[string]string{
+	"unread":   "filterUnread",
+	"starred":  "filterStarred",
+	"archived": "filterArchived",
+	"public":   "filterPublic",
+	"failed":   "filterFailed",
+	"short":    "filterShort",
+	"long":     "filterLong",
+}
+
+// Command suggested by the palette.
+type walgotPaletteSuggestion struct {
+	Name string
+	// Usage for commands taking arguments, keys for actions:
+	Usage     string
+	Help      string
+	NeedsArgs bool
+	Score     int
+	Positions []int
+}
+
+// Retrieve commands and actions available on the view, matching the query.
+// Best matches first, commands taking arguments first on equal score.
+func getPaletteSuggestions(keyMap walgotKeyMap, view, query string) []walgotPaletteSuggestion {
+	var all []walgotPaletteSuggestion
+	for _, c := range paletteCommands {
+		if containsString(c.Views, view) {
+			all = append(all, walgotPaletteSuggestion{Name: c.Name, Usage: c.Usage, Help: c.Help, NeedsArgs: true})
+		}
+	}
+	for _, b := range keyMap.Bindings {
+		if b.View != view || b.Action == "palette" {
+			continue
+		}
+		// Actions with disabled keys can still be run from the palette:
+		keys := ""
+		if b.Binding.Enabled() {
+			keys = b.Binding.Help().Key
+		}
+		if keys == " " {
+			keys = "space"
+		}
+		// The view number of selectView is prompted for:
+		all = append(all, walgotPaletteSuggestion{Name: b.Action, Usage: keys, Help: b.Binding.Help().Desc, NeedsArgs: b.Action == "selectView"})
+	}
+
+	// Only the command name is matched, arguments are typed after it:
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return all
+	}
+	var suggestions []walgotPaletteSuggestion
+	for _, s := range all {
+		if score, positions, ok := fuzzyMatch(fields[0], s.Name); ok {
+			s.Score, s.Positions = score, positions
+			suggestions = append(suggestions, s)
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+
+	return suggestions
+}
+
+// Check if the name is a command or an action available on the view.
+func isPaletteCommand(keyMap walgotKeyMap, view, name string) bool {
+	for _, s := range getPaletteSuggestions(keyMap, view, "") {
+		if s.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add a command line to the history, most recent last.
+func addPaletteHistory(history []string, line string) []string {
+	if len(history) > 0 && history[len(history)-1] == line {
+		return history
+	}
+	history = append(history, line)
+	if len(history) > paletteHistorySize {
+		history = history[len(history)-paletteHistorySize:]
+	}
+
+	return history
+}
+
+// Retrieve a palette command by name.
+func getPaletteCommand(name string) walgotCommand {
+	for _, c := range paletteCommands {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return walgotCommand{}
+}
+
+// Usage error of a palette command.
+func paletteUsageError(name string) error {
+	return errors.New("usage: " + getPaletteCommand(name).Usage)
+}
+
+// Run a palette command line on the view it was opened from.
+// Keybind actions are sent to the view as if their key was pressed,
+// including actions whose keys are disabled.
+func runPaletteCommand(m *model, line string) (tea.Model, tea.Cmd, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return m, nil, errors.New("no command given")
+	}
+	name, args := fields[0], fields[1:]
+	view := m.Palette.View
+
+	if (name == "sort" || name == "filter" || name == "view") && view != "list" {
+		return m, nil, errors.New(name + " is only available on listing page")
+	}
+	if name == "external" && view != "detail" {
+		return m, nil, errors.New(name + " is only available on reading page")
+	}
+
+	switch name {
+	case "tag":
+		cmd, err := runPaletteTagCommand(m, args)
+		return m, cmd, err
+
+	case "reader":
+		cmd, err := runPaletteReaderCommand(m, args)
+		return m, cmd, err
+
+	case "external":
+		if len(args) == 0 || len(args) > 2 || (args[0] != "editor" && args[0] != "pager") {
+			return m, nil, paletteUsageError("external")
+		}
+		format := externalFormatText
+		if len(args) == 2 {
+			format = args[1]
+		}
+		cmd, err := m.openExternal(args[0], format)
+		return m, cmd, err
+
+	case "sort":
+		if len(args) == 0 || len(args) > 2 || !containsString(availableSortFields, args[0]) {
+			return m, nil, paletteUsageError("sort")
+		}
+		sorts := walgotTableSorts{Field: args[0], Order: m.Options.Sorts.Order}
+		if len(args) == 2 {
+			if args[1] != "asc" && args[1] != "desc" {
+				return m, nil, paletteUsageError("sort")
+			}
+			sorts.Order = args[1]
+		}
+		m.Options.Sorts = sorts
+		sortEntries(m.Entries, m.Options.Sorts)
+		m.refreshTableRows()
+		m.Table.GotoTop()
+		return m, nil, nil
+
+	case "filter":
+		if len(args) == 0 {
+			return m, nil, paletteUsageError("filter")
+		}
+		value := strings.Join(args[1:], " ")
+		if action, ok := paletteToggleFilters[args[0]]; ok {
+			listViewFiltersUpdate(action, m)
+		} else if args[0] == "domain" {
+			m.Options.Filters.Domain = value
+		} else if args[0] == "tag" {
+			m.Options.Filters.Tags = parseTags(value)
+		} else if args[0] == "search" {
+			m.Options.Filters.Search = value
+		} else if args[0] == "clear" {
+			m.Options.Filters = walgotTableFilters{}
+		} else {
+			return m, nil, paletteUsageError("filter")
+		}
+		m.refreshTableRows()
+		m.Table.GotoTop()
+		return m, nil, nil
+
+	case "view":
+		viewName := strings.Join(args, " ")
+		index := getSavedViewIndex(m.SavedViews, viewName)
+		if index < 0 {
+			return m, nil, errors.New("unknown view \"" + viewName + "\"")
+		}
+		m.applySavedView(m.SavedViews[index])
+		return m, nil, nil
+	}
+
+	// Keybind actions, selectView takes the view number as argument:
+	if name == "palette" || !m.KeyMap.hasAction(view, name) {
+		return m, nil, errors.New("unknown command \"" + name + "\"")
+	}
+	msg := walgotActionMsg{Action: name}
+	if name == "selectView" {
+		if len(args) == 0 {
+			return m, nil, errors.New("usage: selectView <number>")
+		}
+		number, err := strconv.Atoi(args[0])
+		if err != nil {
+			return m, nil, errors.New("usage: selectView <number>")
+		}
+		msg.Index = number - 1
+	}
+
+	updated, cmd := m.Update(msg)
+	return updated, cmd, nil
+}
+
+// Add or remove tags of the selected entry.
+func runPaletteTagCommand(m *model, args []string) (tea.Cmd, error) {
+	usage := paletteUsageError("tag")
+	if len(args) < 2 || (args[0] != "add" && args[0] != "remove") {
+		return nil, usage
+	}
+	tags := parseTags(strings.Join(args[1:], " "))
+	if len(tags) == 0 {
+		return nil, usage
+	}
+
+	entry := getPaletteSelectedEntry(m)
+	if entry == nil {
+		return nil, errors.New("no article selected")
+	}
+
+	if args[0] == "add" {
+		m.UpdateMessage = "Adding tags…"
+		return requestWallabagEntryEdit(entry.ID, map[string]string{"tags": strings.Join(tags, ",")}, nil), nil
+	}
+
+	var removedTagIDs []int
+	for _, tag := range tags {
+		found := false
+		for _, t := range entry.Tags {
+			if strings.EqualFold(t.Label, tag) {
+				removedTagIDs = append(removedTagIDs, t.ID)
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.New("the article isn't tagged \"" + tag + "\"")
+		}
+	}
+	m.UpdateMessage = "Removing tags…"
+	return requestWallabagEntryEdit(entry.ID, map[string]string{}, removedTagIDs), nil
+}
+
+// Change a reader setting.
+func runPaletteReaderCommand(m *model, args []string) (tea.Cmd, error) {
+	usage := paletteUsageError("reader")
+	if len(args) < 2 {
+		return nil, usage
+	}
+
+	reader := m.Reader
+	var values []int
+	if args[0] == "width" || args[0] == "margins" || args[0] == "spacing" {
+		for _, arg := range args[1:] {
+			value, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, usage
+			}
+			values = append(values, value)
+		}
+	}
+
+	switch {
+	case args[0] == "width" && len(values) == 1:
+		reader.Width = values[0]
+	case args[0] == "margins" && len(values) == 1:
+		reader.MarginLeft, reader.MarginRight = values[0], values[0]
+	case args[0] == "margins" && len(values) == 2:
+		reader.MarginLeft, reader.MarginRight = values[0], values[1]
+	case args[0] == "spacing" && len(values) == 1:
+		reader.LineSpacing = values[0]
+	case args[0] == "align" && len(args) == 2 && (args[1] == readerAlignCenter || args[1] == readerAlignLeft):
+		reader.Centered = args[1] == readerAlignCenter
+	case args[0] == "hyphenation" && len(args) == 2 && (args[1] == "on" || args[1] == "off"):
+		reader.Hyphenation = args[1] == "on"
+	default:
+		return nil, usage
+	}
+	if err := reader.validate(); err != nil {
+		return nil, err
+	}
+
+	m.Reader = reader
+	return m.updateReader(), nil
+}
+
+// Retrieve the entry read, or selected in the list.
+func getPaletteSelectedEntry(m *model) *wallabago.Item {
+	sID := m.SelectedID
+	if sID == 0 {
+		sID = m.getListSelectedID()
+	}
+	if index := getSelectedEntryIndex(m.Entries, sID); index >= 0 {
+		return &m.Entries[index]
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+func TestGetPaletteSuggestions(t *testing.T) {
+	keyMap, _ := newKeyMap(nil)
+
+	var tests = []struct {
+		view          string
+		query         string
+		expectedFirst string
+		expectedFound []string
+	}{
+		{"list", "", "tag", []string{"sort", "filter", "view", "toggleArchive"}},
+		{"list", "srt", "sort", []string{"filterShort"}},
+		{"list", "sort title asc", "sort", []string{}},
+		{"detail", "", "tag", []string{"links", "back"}},
+		{"detail", "tglarch", "toggleArchive", []string{}},
+	}
+
+	for _, test := range tests {
+		suggestions := getPaletteSuggestions(keyMap, test.view, test.query)
+		if len(suggestions) == 0 || suggestions[0].Name != test.expectedFirst {
+			t.Errorf("getPaletteSuggestions(%v, %v): expected %v first, got %v", test.view, test.query, test.expectedFirst, suggestions)
+			continue
+		}
+		for _, name := range test.expectedFound {
+			found := false
+			for _, s := range suggestions {
+				found = found || s.Name == name
+			}
+			if !found {
+				t.Errorf("getPaletteSuggestions(%v, %v): expected %v in suggestions", test.view, test.query, name)
+			}
+		}
+	}
+
+	// Commands not available on the view, and the palette itself, aren't suggested:
+	for _, s := range getPaletteSuggestions(keyMap, "detail", "") {
+		if s.Name == "sort" || s.Name == "palette" {
+			t.Errorf("getPaletteSuggestions(detail): unexpected %v", s.Name)
+		}
+	}
+}
+
+func TestRunPaletteDisabledAction(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{
+		Keybinds: map[string][]string{"filterStarred": {}, "selectView": {}},
+		Views:    []config.WalgotView{{Name: "Coffee break", Short: true}},
+	})
+	m.Reloading = false
+	m.Palette.View = "list"
+
+	updated, _, err := runPaletteCommand(&m, "filterStarred")
+	if u, ok := updated.(model); err != nil || !ok || !u.Options.Filters.Starred {
+		t.Errorf("runPaletteCommand(filterStarred): expected the disabled action to run, got %v", err)
+	}
+	updated, _, err = runPaletteCommand(&m, "selectView 1")
+	if u, ok := updated.(model); err != nil || !ok || !u.Options.Filters.Short {
+		t.Errorf("runPaletteCommand(selectView 1): expected the saved view, got %v", err)
+	}
+}
+
+func TestAddPaletteHistory(t *testing.T) {
+	var history []string
+	history = addPaletteHistory(history, "sort title")
+	history = addPaletteHistory(history, "filter unread")
+	history = addPaletteHistory(history, "filter unread")
+	if fmt.Sprint(history) != fmt.Sprint([]string{"sort title", "filter unread"}) {
+		t.Errorf("addPaletteHistory(): expected no consecutive duplicate, got %v", history)
+	}
+
+	for i := 0; i < paletteHistorySize+10; i++ {
+		history = addPaletteHistory(history, fmt.Sprint(i))
+	}
+	if len(history) != paletteHistorySize || history[len(history)-1] != fmt.Sprint(paletteHistorySize+9) {
+		t.Errorf("addPaletteHistory(): expected %v most recent commands, got %v", paletteHistorySize, history)
+	}
+}
+
+func TestRunPaletteCommand(t *testing.T) {
+	m, _ := NewModel(config.WalgotConfig{Views: []config.WalgotView{{Name: "Coffee break", Short: true}}})
+	m.Entries = []wallabago.Item{
+		{ID: 1, Title: "beta", DomainName: "example.org", IsArchived: 1},
+		{ID: 2, Title: "Alpha", DomainName: "go.dev", Tags: []wallabago.Tag{{ID: 7, Label: "go"}}},
+	}
+	m.TermSize = termSize{120, 40}
+	windowSizeUpdate(&m)
+
+	var tests = []struct {
+		view             string
+		line             string
+		expectedFilters  walgotTableFilters
+		expectedSorts    walgotTableSorts
+		expectedIsErrNil bool
+	}{
+		{"list", "sort title asc", walgotTableFilters{}, walgotTableSorts{Field: "title", Order: "asc"}, true},
+		{"list", "filter unread", walgotTableFilters{Unread: true}, walgotTableSorts{Field: "title", Order: "asc"}, true},
+		{"list", "filter domain go.dev", walgotTableFilters{Unread: true, Domain: "go.dev"}, walgotTableSorts{Field: "title", Order: "asc"}, true},
+		{"list", "filter clear", walgotTableFilters{}, walgotTableSorts{Field: "title", Order: "asc"}, true},
+		{"list", "filter tag go, web", walgotTableFilters{Tags: []string{"go", "web"}}, walgotTableSorts{Field: "title", Order: "asc"}, true},
+		{"list", "view Coffee break", walgotTableFilters{Short: true}, walgotTableSorts{Field: "created", Order: "desc"}, true},
+		{"list", "filterStarred", walgotTableFilters{Short: true, Starred: true}, walgotTableSorts{Field: "created", Order: "desc"}, true},
+		{"list", "sort size", walgotTableFilters{Short: true, Starred: true}, walgotTableSorts{Field: "created", Order: "desc"}, false},
+		{"list", "selectView", walgotTableFilters{Short: true, Starred: true}, walgotTableSorts{Field: "created", Order: "desc"}, false},
+		{"list", "view unknown", walgotTableFilters{Short: true, Starred: true}, walgotTableSorts{Field: "created", Order: "desc"}, false},
+		{"list", "unknown", walgotTableFilters{Short: true, Starred: true}, walgotTableSorts{Field: "created", Order: "desc"}, false},
+		{"detail", "sort title", walgotTableFilters{Short: true, Starred: true}, walgotTableSorts{Field: "created", Order: "desc"}, false},
+	}
+
+	for _, test := range tests {
+		m.Palette.View = test.view
+		m.CurrentView = test.view
+		updated, _, err := runPaletteCommand(&m, test.line)
+		if (err == nil) != test.expectedIsErrNil {
+			t.Errorf("runPaletteCommand(%v): expected error nil %v, got %v", test.line, test.expectedIsErrNil, err)
+		}
+		if u, ok := updated.(model); ok {
+			m = u
+		}
+		if fmt.Sprint(m.Options.Filters) != fmt.Sprint(test.expectedFilters) || m.Options.Sorts != test.expectedSorts {
+			t.Errorf("runPaletteCommand(%v): expected %v %v, got %v %v", test.line, test.expectedFilters, test.expectedSorts, m.Options.Filters, m.Options.Sorts)
+		}
+	}
+
+	// Tags of the selected entry:
+	m.Palette.View = "detail"
+	m.SelectedID = 2
+	for _, line := range []string{"tag add web", "tag remove go"} {
+		if _, cmd, err := runPaletteCommand(&m, line); err != nil || cmd == nil {
+			t.Errorf("runPaletteCommand(%v): expected a command, got %v", line, err)
+		}
+	}
+	for _, line := range []string{"tag remove web", "tag add", "tag rename go"} {
+		if _, _, err := runPaletteCommand(&m, line); err == nil {
+			t.Errorf("runPaletteCommand(%v): expected an error", line)
+		}
+	}
+}
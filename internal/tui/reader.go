@@ -0,0 +1,255 @@
+package tui
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/indent"
+	"github.com/muesli/reflow/wrap"
+)
+
+// Reader alignments.
+const (
+	readerAlignCenter = "center"
+	readerAlignLeft   = "left"
+)
+
+const (
+	// Default width of the text (in columns):
+	readerDefaultWidth = 72
+	// Width limits, also when changed at runtime:
+	readerMinWidth = 20
+	readerMaxWidth = 200
+	// Columns added or removed when changing the width at runtime:
+	readerWidthStep      = 4
+	readerMaxMargin      = 40
+	readerMaxLineSpacing = 2
+)
+
+// Reader settings, how articles are displayed.
+type walgotReader struct {
+	// Width of the text (in columns):
+	Width       int
+	MarginLeft  int
+	MarginRight int
+	// Text centered in the pane, aligned left otherwise:
+	Centered bool
+	// Empty lines between lines of text:
+	LineSpacing int
+	// Break long words with a hyphen, instead of a hard break:
+	Hyphenation bool
+}
+
+// Create the reader settings from the configured ones.
+func newReader(c config.WalgotReader) (walgotReader, error) {
+	reader := walgotReader{
+		Width:       c.Width,
+		MarginLeft:  c.MarginLeft,
+		MarginRight: c.MarginRight,
+		Centered:    c.Align != readerAlignLeft,
+		LineSpacing: c.LineSpacing,
+		Hyphenation: c.Hyphenation,
+	}
+	if reader.Width == 0 {
+		reader.Width = readerDefaultWidth
+	}
+
+	if c.Align != "" && c.Align != readerAlignCenter && c.Align != readerAlignLeft {
+		return walgotReader{Width: readerDefaultWidth, Centered: true}, errors.New("unknown reader alignment \"" + c.Align + "\"")
+	}
+	if err := reader.validate(); err != nil {
+		return walgotReader{Width: readerDefaultWidth, Centered: true}, err
+	}
+
+	return reader, nil
+}
+
+// Check that the reader settings are within limits.
+func (r walgotReader) validate() error {
+	if r.Width < readerMinWidth || r.Width > readerMaxWidth {
+		return errors.New("reader width must be between " + strconv.Itoa(readerMinWidth) + " and " + strconv.Itoa(readerMaxWidth))
+	}
+	if r.MarginLeft < 0 || r.MarginRight < 0 || r.MarginLeft > readerMaxMargin || r.MarginRight > readerMaxMargin {
+		return errors.New("reader margins must be between 0 and " + strconv.Itoa(readerMaxMargin))
+	}
+	if r.LineSpacing < 0 || r.LineSpacing > readerMaxLineSpacing {
+		return errors.New("reader line spacing must be between 0 and " + strconv.Itoa(readerMaxLineSpacing))
+	}
+
+	return nil
+}
+
+// Describe the reader settings, eg: "72 columns, margins 2/2, centered, line spacing 0, hyphenation off".
+func (r walgotReader) description() string {
+	align, hyphenation := readerAlignLeft, "off"
+	if r.Centered {
+		align = "centered"
+	}
+	if r.Hyphenation {
+		hyphenation = "on"
+	}
+
+	return strconv.Itoa(r.Width) + " columns, margins " + strconv.Itoa(r.MarginLeft) + "/" + strconv.Itoa(r.MarginRight) +
+		", " + align + ", line spacing " + strconv.Itoa(r.LineSpacing) + ", hyphenation " + hyphenation
+}
+
+// Retrieve the width of the text and of the viewport displaying it, in a pane of the given width.
+// Centered text has a viewport fitting it, centered in the pane.
+func (r walgotReader) getWidths(paneWidth int) (int, int) {
+	textWidth := r.Width
+	if available := paneWidth - r.MarginLeft - r.MarginRight; textWidth > available {
+		textWidth = available
+	}
+	if textWidth < 10 {
+		textWidth = 10
+	}
+
+	viewportWidth := paneWidth
+	if r.Centered && textWidth+r.MarginLeft+r.MarginRight < paneWidth {
+		viewportWidth = textWidth + r.MarginLeft + r.MarginRight
+	}
+
+	return textWidth, viewportWidth
+}
+
+// Break lines longer than the width, with a hyphen when breaking words if hyphenation is enabled.
+// Broken lines keep their indentation, but lose their styles.
+func breakLongLines(content string, width int, hyphenation bool) string {
+	lines := strings.Split(content, "\n")
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		text := strings.TrimRight(stripStyles(line), " \r")
+		if ansi.PrintableRuneWidth(text) <= width {
+			result = append(result, line)
+			continue
+		}
+
+		trimmed := strings.TrimLeft(text, " ")
+		prefix := strings.Repeat(" ", len(text)-len(trimmed))
+		size := width - len(prefix)
+		if hyphenation {
+			size--
+		}
+		if size < 1 {
+			size = 1
+		}
+
+		pieces := strings.Split(wrap.String(trimmed, size), "\n")
+		for i, piece := range pieces {
+			// Hyphens are only added in the middle of words:
+			if hyphenation && i < len(pieces)-1 && endsWithLetter(piece) && startsWithLetter(pieces[i+1]) {
+				piece += "-"
+			}
+			result = append(result, prefix+piece)
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// Check if a text ends with a letter or a digit.
+func endsWithLetter(s string) bool {
+	r := []rune(s)
+	return len(r) > 0 && (unicode.IsLetter(r[len(r)-1]) || unicode.IsDigit(r[len(r)-1]))
+}
+
+// Check if a text starts with a letter or a digit.
+func startsWithLetter(s string) bool {
+	r := []rune(s)
+	return len(r) > 0 && (unicode.IsLetter(r[0]) || unicode.IsDigit(r[0]))
+}
+
+// Add empty lines between lines of text, keeping the space between blocks (eg: paragraphs) as is.
+func spaceLines(content string, spacing int) string {
+	if spacing <= 0 {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+	result := make([]string, 0, len(lines)*(spacing+1))
+	for i, line := range lines {
+		result = append(result, line)
+		if i < len(lines)-1 && !isBlankLine(line) && !isBlankLine(lines[i+1]) {
+			for j := 0; j < spacing; j++ {
+				result = append(result, "")
+			}
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// Check if a rendered line is empty, ignoring styles.
+func isBlankLine(line string) bool {
+	return strings.TrimSpace(stripStyles(line)) == ""
+}
+
+// Add the left margin to rendered content.
+func addLeftMargin(content string, margin int) string {
+	if margin <= 0 {
+		return content
+	}
+
+	return indent.String(content, uint(margin))
+}
+
+// Apply the reader settings changes to the entry read or previewed, keeping the reading position.
+func (m *model) updateReader() tea.Cmd {
+	_, entryWidth := m.getPanesWidth()
+	if m.isSplitView() {
+		entryWidth -= 2
+	}
+	_, m.Viewport.Width = m.Reader.getWidths(entryWidth)
+
+	entryID := m.SelectedID
+	if entryID == 0 {
+		entryID = m.PreviewID
+	}
+	if entryID > 0 {
+		percent := m.Viewport.ScrollPercent()
+		content := m.setDetailContent(entryID)
+		m.Viewport.SetYOffset(int(percent * float64(strings.Count(content, "\n")+1-m.Viewport.Height)))
+		// Searched terms may be split differently:
+		if m.ContentSearch.Term != "" && entryID == m.SelectedID {
+			m.ContentSearch.Count = len(findContentMatches(strings.Split(content, "\n"), m.ContentSearch.Term))
+			if m.ContentSearch.Current > m.ContentSearch.Count {
+				m.ContentSearch.Current = m.ContentSearch.Count
+			}
+		}
+	}
+
+	m.UpdateMessage = "Reader: " + m.Reader.description()
+	return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+		return wallabagoResponseClearMsg(true)
+	})
+}
+
+// Change the reader settings from a keybind action.
+// Width and line spacing stay within limits.
+func readerUpdate(action string, m *model) tea.Cmd {
+	switch action {
+	case "widerText":
+		if m.Reader.Width+readerWidthStep <= readerMaxWidth {
+			m.Reader.Width += readerWidthStep
+		}
+	case "narrowerText":
+		if m.Reader.Width-readerWidthStep >= readerMinWidth {
+			m.Reader.Width -= readerWidthStep
+		}
+	case "toggleAlign":
+		m.Reader.Centered = !m.Reader.Centered
+	case "toggleSpacing":
+		m.Reader.LineSpacing = (m.Reader.LineSpacing + 1) % (readerMaxLineSpacing + 1)
+	case "toggleHyphenation":
+		m.Reader.Hyphenation = !m.Reader.Hyphenation
+	}
+
+	return m.updateReader()
+}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+)
+
+func TestNewReader(t *testing.T) {
+	var tests = []struct {
+		config      config.WalgotReader
+		expected    walgotReader
+		expectedErr bool
+	}{
+		{config.WalgotReader{}, walgotReader{Width: readerDefaultWidth, Centered: true}, false},
+		{config.WalgotReader{Width: 60, MarginLeft: 2, Align: "left", LineSpacing: 1, Hyphenation: true}, walgotReader{Width: 60, MarginLeft: 2, LineSpacing: 1, Hyphenation: true}, false},
+		{config.WalgotReader{Align: "right"}, walgotReader{Width: readerDefaultWidth, Centered: true}, true},
+		{config.WalgotReader{Width: 10}, walgotReader{Width: readerDefaultWidth, Centered: true}, true},
+		{config.WalgotReader{MarginRight: -1}, walgotReader{Width: readerDefaultWidth, Centered: true}, true},
+		{config.WalgotReader{LineSpacing: 3}, walgotReader{Width: readerDefaultWidth, Centered: true}, true},
+	}
+
+	for _, test := range tests {
+		reader, err := newReader(test.config)
+		if reader != test.expected || (err != nil) != test.expectedErr {
+			t.Errorf("newReader(%+v): expected %+v (error: %v), got %+v (%v)", test.config, test.expected, test.expectedErr, reader, err)
+		}
+	}
+}
+
+func TestReaderGetWidths(t *testing.T) {
+	var tests = []struct {
+		reader           walgotReader
+		paneWidth        int
+		expectedText     int
+		expectedViewport int
+	}{
+		{walgotReader{Width: 72, Centered: true}, 120, 72, 72},
+		{walgotReader{Width: 72, MarginLeft: 4, MarginRight: 2, Centered: true}, 120, 72, 78},
+		{walgotReader{Width: 72}, 120, 72, 120},
+		// Narrow pane:
+		{walgotReader{Width: 72, MarginLeft: 4, MarginRight: 4, Centered: true}, 60, 52, 60},
+	}
+
+	for _, test := range tests {
+		text, viewport := test.reader.getWidths(test.paneWidth)
+		if text != test.expectedText || viewport != test.expectedViewport {
+			t.Errorf("getWidths(%+v, %v): expected %v, %v, got %v, %v", test.reader, test.paneWidth, test.expectedText, test.expectedViewport, text, viewport)
+		}
+	}
+}
+
+func TestBreakLongLines(t *testing.T) {
+	var tests = []struct {
+		content     string
+		hyphenation bool
+		expected    string
+	}{
+		{"  Short", true, "  Short"},
+		{"  abcdefghijkl", false, "  abcdefgh\n  ijkl"},
+		{"  abcdefghijkl", true, "  abcdefg-\n  hijkl"},
+		// No hyphen when not breaking a word:
+		{"  abcdef/ghijkl", true, "  abcdef/\n  ghijkl"},
+	}
+
+	for _, test := range tests {
+		if result := breakLongLines(test.content, 10, test.hyphenation); result != test.expected {
+			t.Errorf("breakLongLines(%q, %v): expected %q, got %q", test.content, test.hyphenation, test.expected, result)
+		}
+	}
+}
+
+func TestSpaceLines(t *testing.T) {
+	content := "First line\nSecond line\n\nNew paragraph"
+
+	if result := spaceLines(content, 0); result != content {
+		t.Errorf("spaceLines(0): expected unchanged content, got %q", result)
+	}
+
+	// Space between paragraphs is kept as is:
+	expected := strings.Join([]string{"First line", "", "", "Second line", "", "New paragraph"}, "\n")
+	if result := spaceLines(content, 2); result != expected {
+		t.Errorf("spaceLines(2): expected %q, got %q", expected, result)
+	}
+}
@@ -0,0 +1,260 @@
+package tui
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/Strubbl/wallabago/v7"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// Sort fields, the first ones being supported by wallabag API.
+var availableSortFields = []string{"created", "updated", "archived", "title", "domain", "reading"}
+
+// Number of sort fields supported by wallabag API.
+const nbAPISortFields = 3
+
+// Saved view of the list, combining filters, sort and columns.
+type walgotSavedView struct {
+	Name    string
+	Filters walgotTableFilters
+	Sorts   walgotTableSorts
+	Columns []walgotColumn
+}
+
+// Create a saved view from configuration.
+// Sort and columns not configured use the given default ones.
+func newSavedView(view config.WalgotView, defaultSorts walgotTableSorts, defaultColumns []walgotColumn) (walgotSavedView, error) {
+	if strings.TrimSpace(view.Name) == "" {
+		return walgotSavedView{}, errors.New("views need a name")
+	}
+
+	sorts := defaultSorts
+	if view.Sorting != "" {
+		if !containsString(availableSortFields, view.Sorting) {
+			return walgotSavedView{}, errors.New("unknown sorting \"" + view.Sorting + "\" for view \"" + view.Name + "\"")
+		}
+		sorts.Field = view.Sorting
+	}
+	if view.Order != "" {
+		if view.Order != "asc" && view.Order != "desc" {
+			return walgotSavedView{}, errors.New("unknown order \"" + view.Order + "\" for view \"" + view.Name + "\"")
+		}
+		sorts.Order = view.Order
+	}
+
+	columns := defaultColumns
+	if len(view.Columns) > 0 {
+		var err error
+		if columns, err = newColumns(view.Columns); err != nil {
+			return walgotSavedView{}, errors.New(err.Error() + " for view \"" + view.Name + "\"")
+		}
+	}
+
+	return walgotSavedView{
+		Name: view.Name,
+		Filters: walgotTableFilters{
+			Unread:   view.Unread,
+			Starred:  view.Starred,
+			Archived: view.Archived,
+			Public:   view.Public,
+			Failed:   view.Failed,
+			Short:    view.Short,
+			Long:     view.Long,
+			Tags:     view.Tags,
+			Domain:   view.Domain,
+			Search:   view.Search,
+		},
+		Sorts:   sorts,
+		Columns: columns,
+	}, nil
+}
+
+// Create saved views from configuration.
+// Views with the same name replace the previous ones.
+func newSavedViews(configured []config.WalgotView, defaultSorts walgotTableSorts, defaultColumns []walgotColumn) ([]walgotSavedView, error) {
+	var views []walgotSavedView
+	for _, v := range configured {
+		view, err := newSavedView(v, defaultSorts, defaultColumns)
+		if err != nil {
+			return nil, err
+		}
+		views = addSavedView(views, view)
+	}
+
+	return views, nil
+}
+
+// Add a saved view, replacing any view with the same name.
+func addSavedView(views []walgotSavedView, view walgotSavedView) []walgotSavedView {
+	if index := getSavedViewIndex(views, view.Name); index >= 0 {
+		views[index] = view
+		return views
+	}
+
+	return append(views, view)
+}
+
+// Retrieve the index of a saved view by name.
+// Returns -1 if not found.
+func getSavedViewIndex(views []walgotSavedView, name string) int {
+	for i := range views {
+		if views[i].Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Retrieve the name of the saved view matching the current filters and sort.
+// Returns an empty string if none is matching.
+func getActiveSavedView(views []walgotSavedView, options walgotTableOptions) string {
+	for _, v := range views {
+		if reflect.DeepEqual(v.Filters, options.Filters) && v.Sorts == options.Sorts {
+			return v.Name
+		}
+	}
+
+	return ""
+}
+
+// Create the view configuration from the current filters, sort and columns.
+func getViewConfig(name string, options walgotTableOptions, columns []walgotColumn) config.WalgotView {
+	var configColumns []config.WalgotColumn
+	for _, c := range columns {
+		configColumns = append(configColumns, config.WalgotColumn{
+			Name:     c.Name,
+			Width:    c.Width,
+			Priority: c.Priority,
+		})
+	}
+
+	return config.WalgotView{
+		Name:     name,
+		Unread:   options.Filters.Unread,
+		Starred:  options.Filters.Starred,
+		Archived: options.Filters.Archived,
+		Public:   options.Filters.Public,
+		Failed:   options.Filters.Failed,
+		Short:    options.Filters.Short,
+		Long:     options.Filters.Long,
+		Tags:     options.Filters.Tags,
+		Domain:   options.Filters.Domain,
+		Search:   options.Filters.Search,
+		Sorting:  options.Sorts.Field,
+		Order:    options.Sorts.Order,
+		Columns:  configColumns,
+	}
+}
+
+// Display a saved view.
+func (m *model) applySavedView(view walgotSavedView) {
+	m.Options.Filters = view.Filters
+	if m.Options.Sorts != view.Sorts {
+		m.Options.Sorts = view.Sorts
+		sortEntries(m.Entries, m.Options.Sorts)
+	}
+	m.ListColumns = view.Columns
+
+	// Columns may have changed, table needs to be regenerated:
+	if m.Ready {
+		windowSizeUpdate(m)
+		m.Table.GotoTop()
+	}
+}
+
+// Check if wallabag API can sort entries on the field.
+// Otherwise, entries are retrieved by creation date and sorted locally.
+func isAPISortField(field string) bool {
+	return containsString(availableSortFields[:nbAPISortFields], field)
+}
+
+// Sort entries locally, the same way wallabag API does.
+func sortEntries(entries []wallabago.Item, sorts walgotTableSorts) {
+	getDate := func(entry *wallabago.Item) *wallabago.WallabagTime {
+		switch sorts.Field {
+		case "updated":
+			return entry.UpdatedAt
+		case "archived":
+			return entry.ArchivedAt
+		}
+		return entry.CreatedAt
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := &entries[i], &entries[j]
+		compare := 0
+		switch sorts.Field {
+		case "title":
+			compare = strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		case "domain":
+			compare = strings.Compare(a.DomainName, b.DomainName)
+		case "reading":
+			compare = a.ReadingTime - b.ReadingTime
+		default:
+			da, db := getDate(a), getDate(b)
+			// Entries without date are always last:
+			if da == nil || db == nil {
+				return da != nil
+			}
+			if da.Time.Before(db.Time) {
+				compare = -1
+			} else if da.Time.After(db.Time) {
+				compare = 1
+			}
+		}
+
+		if sorts.Order == "asc" {
+			return compare < 0
+		}
+		return compare > 0
+	})
+}
+
+// Create saved views table rows, the first column being the view number.
+func getSavedViewTableRows(views []walgotSavedView) []table.Row {
+	r := []table.Row{}
+	for i, v := range views {
+		r = append(r, table.Row{
+			strconv.Itoa(i + 1),
+			v.Name,
+			strings.Join(getFiltersDescription(v.Filters), ", "),
+			v.Sorts.Field + " " + v.Sorts.Order,
+		})
+	}
+
+	return r
+}
+
+// Columns of the saved views table.
+func getSavedViewColumns(maxWidth int) []walgotColumn {
+	// Cells have a padding of 1 on each side:
+	filtersWidth := maxWidth - (3 + 2) - (20 + 2) - (16 + 2) - 2
+	if filtersWidth < flexibleColumnMinWidth {
+		filtersWidth = flexibleColumnMinWidth
+	}
+
+	return []walgotColumn{
+		{Name: "number", Title: "#", Width: 3},
+		{Name: "name", Title: "Name", Width: 20},
+		{Name: "filters", Title: "Filters", Width: filtersWidth},
+		{Name: "sort", Title: "Sort", Width: 16},
+	}
+}
+
+// Check if a string is in the list.
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+func TestNewSavedViews(t *testing.T) {
+	defaultSorts := walgotTableSorts{Field: "created", Order: "desc"}
+	defaultColumns, _ := newColumns(nil)
+
+	var tests = []struct {
+		input            []config.WalgotView
+		expectedNames    []string
+		expectedIsErrNil bool
+	}{
+		{nil, []string{}, true},
+		{
+			[]config.WalgotView{{Name: "coffee", Unread: true, Short: true}, {Name: "go", Tags: []string{"go"}}},
+			[]string{"coffee", "go"},
+			true,
+		},
+		{
+			[]config.WalgotView{{Name: "coffee", Unread: true}, {Name: "coffee", Starred: true}},
+			[]string{"coffee"},
+			true,
+		},
+		{[]config.WalgotView{{Name: ""}}, []string{}, false},
+		{[]config.WalgotView{{Name: "sorted", Sorting: "size"}}, []string{}, false},
+		{[]config.WalgotView{{Name: "ordered", Order: "up"}}, []string{}, false},
+		{[]config.WalgotView{{Name: "columns", Columns: []config.WalgotColumn{{Name: "unknown"}}}}, []string{}, false},
+	}
+
+	for _, test := range tests {
+		views, err := newSavedViews(test.input, defaultSorts, defaultColumns)
+		if (err == nil) != test.expectedIsErrNil {
+			t.Errorf("newSavedViews(%v): expected error nil %v, got %v", test.input, test.expectedIsErrNil, err)
+		}
+		names := []string{}
+		for _, v := range views {
+			names = append(names, v.Name)
+		}
+		if fmt.Sprint(names) != fmt.Sprint(test.expectedNames) {
+			t.Errorf("newSavedViews(%v): expected %v, got %v", test.input, test.expectedNames, names)
+		}
+	}
+
+	views, _ := newSavedViews([]config.WalgotView{{Name: "old", Sorting: "updated", Order: "asc", Columns: []config.WalgotColumn{{Name: "title"}}}}, defaultSorts, defaultColumns)
+	if views[0].Sorts.Field != "updated" || views[0].Sorts.Order != "asc" || len(views[0].Columns) != 1 {
+		t.Errorf("newSavedViews(): expected sort and columns from configuration, got %v", views[0])
+	}
+}
+
+func TestGetActiveSavedView(t *testing.T) {
+	defaultSorts := walgotTableSorts{Field: "created", Order: "desc"}
+	views, _ := newSavedViews([]config.WalgotView{
+		{Name: "coffee", Unread: true, Short: true},
+		{Name: "go", Tags: []string{"go"}},
+	}, defaultSorts, nil)
+
+	var tests = []struct {
+		input    walgotTableOptions
+		expected string
+	}{
+		{walgotTableOptions{Filters: walgotTableFilters{Unread: true, Short: true}, Sorts: defaultSorts}, "coffee"},
+		{walgotTableOptions{Filters: walgotTableFilters{Tags: []string{"go"}}, Sorts: defaultSorts}, "go"},
+		{walgotTableOptions{Filters: walgotTableFilters{Unread: true}, Sorts: defaultSorts}, ""},
+		{walgotTableOptions{Filters: walgotTableFilters{Unread: true, Short: true}, Sorts: walgotTableSorts{Field: "created", Order: "asc"}}, ""},
+	}
+
+	for _, test := range tests {
+		if output := getActiveSavedView(views, test.input); output != test.expected {
+			t.Errorf("getActiveSavedView(%v): expected %v, got %v", test.input, test.expected, output)
+		}
+	}
+}
+
+func TestGetViewConfig(t *testing.T) {
+	columns, _ := newColumns([]config.WalgotColumn{{Name: "title"}, {Name: "tags", Width: 30}})
+	options := walgotTableOptions{
+		Filters: walgotTableFilters{Starred: true, Domain: "example.org"},
+		Sorts:   walgotTableSorts{Field: "updated", Order: "asc"},
+	}
+
+	view := getViewConfig("mine", options, columns)
+	saved, err := newSavedView(view, walgotTableSorts{Field: "created", Order: "desc"}, nil)
+	if err != nil {
+		t.Errorf("getViewConfig(): expected a valid view, got %v", err)
+	}
+	if getActiveSavedView([]walgotSavedView{saved}, options) != "mine" {
+		t.Errorf("getViewConfig(): expected view matching options, got %v", saved)
+	}
+	if fmt.Sprint(saved.Columns) != fmt.Sprint(columns) {
+		t.Errorf("getViewConfig(): expected columns %v, got %v", columns, saved.Columns)
+	}
+}
+
+func TestSortEntries(t *testing.T) {
+	date := func(day int) *wallabago.WallabagTime {
+		return &wallabago.WallabagTime{Time: time.Date(2022, time.December, day, 0, 0, 0, 0, time.UTC)}
+	}
+	entries := []wallabago.Item{
+		{ID: 1, Title: "alpha", ReadingTime: 2, CreatedAt: date(2), UpdatedAt: date(5)},
+		{ID: 2, Title: "Gamma", ReadingTime: 5, CreatedAt: date(3), UpdatedAt: date(4), ArchivedAt: date(6)},
+		{ID: 3, Title: "beta", ReadingTime: 9, CreatedAt: date(1), UpdatedAt: date(6)},
+	}
+
+	var tests = []struct {
+		input    walgotTableSorts
+		expected []int
+	}{
+		{walgotTableSorts{Field: "created", Order: "desc"}, []int{2, 1, 3}},
+		{walgotTableSorts{Field: "created", Order: "asc"}, []int{3, 1, 2}},
+		{walgotTableSorts{Field: "updated", Order: "desc"}, []int{3, 1, 2}},
+		{walgotTableSorts{Field: "archived", Order: "desc"}, []int{2, 3, 1}},
+		{walgotTableSorts{Field: "title", Order: "asc"}, []int{1, 3, 2}},
+		{walgotTableSorts{Field: "reading", Order: "desc"}, []int{3, 2, 1}},
+	}
+
+	for _, test := range tests {
+		sortEntries(entries, test.input)
+		var ids []int
+		for _, e := range entries {
+			ids = append(ids, e.ID)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(test.expected) {
+			t.Errorf("sortEntries(%v): expected %v, got %v", test.input, test.expected, ids)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Match of the searched term in rendered content.
+type walgotMatch struct {
+	Line int
+	// Position (rune index) in the line without styles:
+	Start int
+	End   int
+}
+
+// Find the matches of a term in rendered content lines (case insensitive), in reading order.
+func findContentMatches(lines []string, term string) []walgotMatch {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return nil
+	}
+
+	var matches []walgotMatch
+	length := utf8.RuneCountInString(term)
+	for i, line := range lines {
+		// Lowercase keeps the number of runes, positions are the same:
+		text := strings.ToLower(stripStyles(line))
+		start := 0
+		for {
+			index := strings.Index(text, term)
+			if index < 0 {
+				break
+			}
+			start += utf8.RuneCountInString(text[:index])
+			matches = append(matches, walgotMatch{Line: i, Start: start, End: start + length})
+			start += length
+			text = text[index+len(term):]
+		}
+	}
+
+	return matches
+}
+
+// Highlight the matches of a term in rendered content, the focused one (numbered from 1) with its own style.
+// Styles of lines with matches are removed, to keep the highlight readable.
+func highlightContentMatches(content, term string, current int, style, currentStyle lipgloss.Style) string {
+	lines := strings.Split(content, "\n")
+	matches := findContentMatches(lines, term)
+
+	for i := 0; i < len(matches); {
+		index := matches[i].Line
+		text := []rune(stripStyles(lines[index]))
+		var b strings.Builder
+		position := 0
+		for ; i < len(matches) && matches[i].Line == index; i++ {
+			matchStyle := style
+			if i+1 == current {
+				matchStyle = currentStyle
+			}
+			b.WriteString(string(text[position:matches[i].Start]))
+			b.WriteString(matchStyle.Render(string(text[matches[i].Start:matches[i].End])))
+			position = matches[i].End
+		}
+		b.WriteString(string(text[position:]))
+		lines[index] = b.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Describe the search within the entry read, eg: "go: match 2 of 5".
+func getContentSearchInfo(search walgotContentSearch) string {
+	if search.Count == 0 {
+		return search.Term + ": no match"
+	}
+
+	return search.Term + ": match " + strconv.Itoa(search.Current) + " of " + strconv.Itoa(search.Count)
+}
+
+// Search a term within the entry read, focusing the first match from the top of the viewport.
+// An empty term clears the search.
+func (m *model) searchContent(term string) tea.Cmd {
+	m.ContentSearch = walgotContentSearch{Term: strings.TrimSpace(term)}
+	if m.ContentSearch.Term == "" {
+		m.focusMatch(0)
+		return nil
+	}
+
+	content, _, _ := getDetailViewportContent(m.SelectedID, m.Entries, m.getContentOptions())
+	matches := findContentMatches(strings.Split(content, "\n"), m.ContentSearch.Term)
+	if len(matches) == 0 {
+		m.focusMatch(0)
+		m.UpdateMessage = "No match for \"" + m.ContentSearch.Term + "\""
+		return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	}
+
+	current := 1
+	for i, match := range matches {
+		if match.Line >= m.Viewport.YOffset {
+			current = i + 1
+			break
+		}
+	}
+	m.focusMatch(current)
+
+	return nil
+}
+
+// Focus a match of the searched term, scrolling to it if it isn't visible.
+// Number 0 removes the focus.
+func (m *model) focusMatch(number int) {
+	m.ContentSearch.Current = number
+	content := m.setDetailContent(m.SelectedID)
+
+	matches := findContentMatches(strings.Split(content, "\n"), m.ContentSearch.Term)
+	m.ContentSearch.Count = len(matches)
+	if number == 0 || number > len(matches) {
+		return
+	}
+
+	line := matches[number-1].Line
+	if line < m.Viewport.YOffset || line >= m.Viewport.YOffset+m.Viewport.Height {
+		m.Viewport.SetYOffset(line - m.Viewport.Height/3)
+	}
+}
+
+// Retrieve the match to focus after (or before, going backward) the focused one.
+func (m *model) getNextMatch(backward bool) int {
+	count := m.ContentSearch.Count
+	if backward && m.ContentSearch.Current == 0 {
+		return count
+	}
+	if backward {
+		return (m.ContentSearch.Current+count-2)%count + 1
+	}
+
+	return m.ContentSearch.Current%count + 1
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestFindContentMatches(t *testing.T) {
+	lines := []string{
+		"  Go, \x1b[1mgo\x1b[0m and GOPHERS",
+		"  Nothing here",
+		"  Déjà go",
+	}
+
+	var tests = []struct {
+		term     string
+		expected []walgotMatch
+	}{
+		{"go", []walgotMatch{{0, 2, 4}, {0, 6, 8}, {0, 13, 15}, {2, 7, 9}}},
+		{" Nothing ", []walgotMatch{{1, 2, 9}}},
+		{"déjà", []walgotMatch{{2, 2, 6}}},
+		{"rust", nil},
+		{" ", nil},
+	}
+
+	for _, test := range tests {
+		if matches := findContentMatches(lines, test.term); !reflect.DeepEqual(matches, test.expected) {
+			t.Errorf("findContentMatches(%q): expected %v, got %v", test.term, test.expected, matches)
+		}
+	}
+}
+
+func TestHighlightContentMatches(t *testing.T) {
+	content := "  Go and \x1b[1mgo\x1b[0m\n  Nothing here\n  Déjà go"
+	style := lipgloss.NewStyle().Underline(true)
+	currentStyle := lipgloss.NewStyle().Reverse(true)
+
+	expected := "  " + style.Render("Go") + " and " + style.Render("go") + "\n  Nothing here\n  Déjà " + currentStyle.Render("go")
+	if result := highlightContentMatches(content, "go", 3, style, currentStyle); result != expected {
+		t.Errorf("highlightContentMatches(): expected %q, got %q", expected, result)
+	}
+}
+
+func TestGetContentSearchInfo(t *testing.T) {
+	var tests = []struct {
+		search   walgotContentSearch
+		expected string
+	}{
+		{walgotContentSearch{Term: "go", Current: 2, Count: 5}, "go: match 2 of 5"},
+		{walgotContentSearch{Term: "rust"}, "rust: no match"},
+	}
+
+	for _, test := range tests {
+		if info := getContentSearchInfo(test.search); info != test.expected {
+			t.Errorf("getContentSearchInfo(%v): expected %q, got %q", test.search, test.expected, info)
+		}
+	}
+}
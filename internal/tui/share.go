@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"errors"
+	"log"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/Strubbl/wallabago/v7"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Share menu option.
+type walgotShareOption struct {
+	Action string
+	Text   string
+}
+
+// Options of the share menu, in display order.
+var shareOptions = []walgotShareOption{
+	{"markdown", "Copy as Markdown link"},
+	{"org", "Copy as org-mode link"},
+	{"citation", "Copy as citation (title, domain and date)"},
+	{"public", "Copy public link, publishing the article if needed"},
+	{"command", "Send with the share command"},
+}
+
+// Share menu of an entry.
+type walgotShare struct {
+	EntryID int
+	Cursor  int
+	// View the menu was opened from, displayed again when closing it:
+	View string
+}
+
+// Entry published to share its public link message.
+type walgotEntryPublishedMsg struct {
+	Entry wallabago.Item
+}
+
+// Share command ended message.
+type walgotShareSentMsg struct {
+	err error
+}
+
+// Retrieve the public link of an entry, empty if it isn't public.
+func getPublicURL(entry *wallabago.Item) string {
+	if !entry.IsPublic || entry.UID == "" {
+		return ""
+	}
+
+	return wallabago.Config.WallabagURL + "/share/" + entry.UID
+}
+
+// Retrieve an entry formatted to be shared: "markdown", "org" link or "citation".
+// Citations use the published date if known, the date it was saved otherwise.
+func getShareText(entry *wallabago.Item, format, dateFormat string) string {
+	title := strings.Join(strings.Fields(entry.Title), " ")
+	if title == "" {
+		title = entry.URL
+	}
+
+	switch format {
+	case "markdown":
+		return "[" + strings.NewReplacer("[", "\\[", "]", "\\]").Replace(title) + "](" + entry.URL + ")"
+	case "org":
+		return "[[" + entry.URL + "][" + strings.NewReplacer("[", "(", "]", ")").Replace(title) + "]]"
+	case "citation":
+		citation := "\"" + title + "\""
+		if entry.DomainName != "" {
+			citation += ", " + entry.DomainName
+		}
+		date := entry.PublishedAt
+		if date == nil || date.IsZero() {
+			date = entry.CreatedAt
+		}
+		if date != nil && !date.IsZero() {
+			citation += ", " + date.Format(dateFormat)
+		}
+		return citation + ". " + entry.URL
+	}
+
+	return ""
+}
+
+// Retrieve the message given to the share command: the title and link of an entry,
+// the public link if the entry is public.
+func getShareMessage(entry *wallabago.Item) string {
+	url := entry.URL
+	if publicURL := getPublicURL(entry); publicURL != "" {
+		url = publicURL
+	}
+
+	return strings.Join(strings.Fields(entry.Title), " ") + "\n" + url + "\n"
+}
+
+// Command piping a message to the share command.
+func shareCommand(command, message string) tea.Cmd {
+	return func() tea.Msg {
+		args := strings.Fields(command)
+		if len(args) == 0 {
+			return walgotShareSentMsg{err: errors.New("no share command")}
+		}
+		c := exec.Command(args[0], args[1:]...)
+		c.Stdin = strings.NewReader(message)
+		if output, err := c.CombinedOutput(); err != nil {
+			return walgotShareSentMsg{err: errors.New(err.Error() + ": " + strings.TrimSpace(string(output)))}
+		}
+
+		return walgotShareSentMsg{}
+	}
+}
+
+// Callback for publishing an entry via API, to share its public link.
+func requestWallabagEntryPublish(entryID int) tea.Cmd {
+	return func() tea.Msg {
+		msg := requestWallabagEntryUpdate(entryID, map[string]string{"public": "1"})()
+		if v, ok := msg.(wallabagoResponseEntityUpdateMsg); ok {
+			return walgotEntryPublishedMsg{Entry: v.UpdatedEntry}
+		}
+
+		return msg
+	}
+}
+
+// Copy a shared text, displaying a message.
+func (m *model) copySharedText(text, message string) tea.Cmd {
+	if err := m.copyLinkToClipboard(text); err != nil {
+		m.Dialog.Message = "Couldn't copy link"
+		if m.DebugMode {
+			log.Println("Error while copying link")
+			log.Println(err)
+		}
+		return nil
+	}
+
+	m.UpdateMessage = message
+	return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+		return wallabagoResponseClearMsg(true)
+	})
+}
+
+// Share the entry of the share menu with an option of the menu.
+func (m *model) shareEntry(action string) tea.Cmd {
+	index := getSelectedEntryIndex(m.Entries, m.Share.EntryID)
+	if index < 0 {
+		return nil
+	}
+	entry := &m.Entries[index]
+
+	switch action {
+	case "markdown":
+		return m.copySharedText(getShareText(entry, action, m.DateFormat), "Markdown link copied")
+	case "org":
+		return m.copySharedText(getShareText(entry, action, m.DateFormat), "Org-mode link copied")
+	case "citation":
+		return m.copySharedText(getShareText(entry, action, m.DateFormat), "Citation copied")
+	case "public":
+		if publicURL := getPublicURL(entry); publicURL != "" {
+			return m.copySharedText(publicURL, "Public link copied")
+		}
+		m.UpdateMessage = "Publishing article…"
+		return requestWallabagEntryPublish(entry.ID)
+	case "command":
+		if m.ShareCommand == "" {
+			m.UpdateMessage = "No share command configured (\"ShareCommand\" config)"
+			return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+				return wallabagoResponseClearMsg(true)
+			})
+		}
+		m.UpdateMessage = "Sending article…"
+		return shareCommand(m.ShareCommand, getShareMessage(entry))
+	}
+
+	return nil
+}
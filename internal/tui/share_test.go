@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Strubbl/wallabago/v7"
+)
+
+func TestGetShareText(t *testing.T) {
+	created := &wallabago.WallabagTime{Time: time.Date(2022, time.December, 5, 10, 0, 0, 0, time.UTC)}
+	published := &wallabago.WallabagTime{Time: time.Date(2022, time.November, 20, 10, 0, 0, 0, time.UTC)}
+	entry := &wallabago.Item{
+		Title:      "Go [release]  notes",
+		URL:        "https://go.dev/notes",
+		DomainName: "go.dev",
+		CreatedAt:  created,
+	}
+
+	var tests = []struct {
+		format    string
+		published *wallabago.WallabagTime
+		expected  string
+	}{
+		{"markdown", nil, "[Go \\[release\\] notes](https://go.dev/notes)"},
+		{"org", nil, "[[https://go.dev/notes][Go (release) notes]]"},
+		{"citation", nil, "\"Go [release] notes\", go.dev, 2022-12-05. https://go.dev/notes"},
+		// Published date first:
+		{"citation", published, "\"Go [release] notes\", go.dev, 2022-11-20. https://go.dev/notes"},
+	}
+
+	for _, test := range tests {
+		entry.PublishedAt = test.published
+		if text := getShareText(entry, test.format, defaultDateFormat); text != test.expected {
+			t.Errorf("getShareText(%q): expected %q, got %q", test.format, test.expected, text)
+		}
+	}
+}
+
+func TestGetShareMessage(t *testing.T) {
+	wallabagURL := wallabago.Config.WallabagURL
+	t.Cleanup(func() {
+		wallabago.Config.WallabagURL = wallabagURL
+	})
+	wallabago.Config.WallabagURL = "https://wallabag.example.org"
+	entry := &wallabago.Item{Title: "Go release notes", URL: "https://go.dev/notes", UID: "abc123"}
+
+	if message := getShareMessage(entry); message != "Go release notes\nhttps://go.dev/notes\n" {
+		t.Errorf("getShareMessage: expected the original link, got %q", message)
+	}
+
+	entry.IsPublic = true
+	if message := getShareMessage(entry); message != "Go release notes\nhttps://wallabag.example.org/share/abc123\n" {
+		t.Errorf("getShareMessage: expected the public link, got %q", message)
+	}
+}
+
+func TestShareCommand(t *testing.T) {
+	if msg, ok := shareCommand("  ", "Message")().(walgotShareSentMsg); !ok || msg.err == nil {
+		t.Errorf("shareCommand: expected an error without command")
+	}
+}
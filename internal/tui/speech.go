@@ -0,0 +1,327 @@
+package tui
+
+import (
+	"errors"
+	"log"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Strubbl/wallabago/v7"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// Link numbers and URLs aren't read aloud:
+	speechLinkRE      = regexp.MustCompile(`\[\d+\]|<[^>\s]+>`)
+	speechParagraphRE = regexp.MustCompile(`\r?\n[ \t]*\r?\n`)
+)
+
+// Number of words identifying the start and end of a paragraph in rendered content.
+const speechMatchWords = 3
+
+// Reading aloud of the entry read.
+type walgotSpeech struct {
+	Paragraphs []string
+	// Number of the paragraph being read (0 if not reading aloud):
+	Current int
+	Paused  bool
+	// Process reading the current paragraph, nil when paused:
+	Process *exec.Cmd
+}
+
+// Lines of a paragraph in rendered content, -1 if not found.
+type walgotParagraphLines struct {
+	Start int
+	End   int
+}
+
+// Speech command message, when a paragraph has been read.
+type walgotSpeechMsg struct {
+	Process *exec.Cmd
+	err     error
+}
+
+// Retrieve the paragraphs of an entry content to read aloud, as plain text.
+func getSpeechParagraphs(contentHTML string) []string {
+	text, _ := getCleanedContentAndLinks(contentHTML)
+
+	var paragraphs []string
+	for _, paragraph := range speechParagraphRE.Split(text, -1) {
+		paragraph = strings.Join(strings.Fields(speechLinkRE.ReplaceAllString(paragraph, " ")), " ")
+		if len(speechWords(paragraph)) > 0 {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+
+	return paragraphs
+}
+
+// Retrieve the words of a text, lowercased and without punctuation, to compare texts rendered differently.
+func speechWords(text string) []string {
+	var words []string
+	for _, field := range strings.Fields(speechLinkRE.ReplaceAllString(text, " ")) {
+		word := strings.ToLower(strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}))
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// Find the lines of paragraphs in rendered content lines, from their first and last words.
+// Paragraphs are searched in order, each after the previous one found.
+func locateParagraphs(lines []string, paragraphs []string) []walgotParagraphLines {
+	// Words of the content, with their line:
+	var words []string
+	var wordLines []int
+	for i, line := range lines {
+		for _, word := range speechWords(stripStyles(line)) {
+			words = append(words, word)
+			wordLines = append(wordLines, i)
+		}
+	}
+
+	located := make([]walgotParagraphLines, len(paragraphs))
+	position := 0
+	for i, paragraph := range paragraphs {
+		located[i] = walgotParagraphLines{Start: -1, End: -1}
+		paragraphWords := speechWords(paragraph)
+		n := speechMatchWords
+		if len(paragraphWords) < n {
+			n = len(paragraphWords)
+		}
+
+		start := findWords(words, paragraphWords[:n], position)
+		if start < 0 {
+			continue
+		}
+		end := findWords(words, paragraphWords[len(paragraphWords)-n:], start)
+		if end < 0 {
+			end = start
+		}
+		position = end + n
+		located[i] = walgotParagraphLines{Start: wordLines[start], End: wordLines[end+n-1]}
+	}
+
+	return located
+}
+
+// Find consecutive words in a list of words, from a position. Returns -1 if not found.
+func findWords(words, search []string, from int) int {
+	for i := from; i+len(search) <= len(words); i++ {
+		found := true
+		for j, word := range search {
+			if words[i+j] != word {
+				found = false
+				break
+			}
+		}
+		if found {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Highlight lines of rendered content, removing their styles.
+func highlightContentLines(content string, start, end int, style lipgloss.Style) string {
+	lines := strings.Split(content, "\n")
+	for i := start; i >= 0 && i <= end && i < len(lines); i++ {
+		lines[i] = style.Render(stripStyles(lines[i]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Command reading a text aloud with the speech command, the text being given on stdin.
+// The process is started right away, the command waits for its end.
+func speakCommand(command, text string) (*exec.Cmd, tea.Cmd, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, nil, errors.New("no speech command configured (\"SpeechCommand\" config)")
+	}
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdin = strings.NewReader(text)
+	if err := c.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	return c, func() tea.Msg {
+		return walgotSpeechMsg{Process: c, err: c.Wait()}
+	}, nil
+}
+
+// WriteSpeech writes an entry read aloud to an audio file, with the speech file command.
+// The text is given on stdin, the file path as last argument.
+func WriteSpeech(command string, entry *wallabago.Item, file string) error {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return errors.New("no speech file command configured (\"SpeechFileCommand\" config)")
+	}
+
+	text := entry.Title + "\n\n" + strings.Join(getSpeechParagraphs(entry.Content), "\n\n")
+	c := exec.Command(args[0], append(args[1:], file)...)
+	c.Stdin = strings.NewReader(text)
+	if output, err := c.CombinedOutput(); err != nil {
+		return errors.New(err.Error() + ": " + strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// Describe the reading aloud, eg: "Reading aloud 3/12".
+func getSpeechInfo(speech walgotSpeech) string {
+	state := "Reading aloud "
+	if speech.Paused {
+		state = "Paused "
+	}
+
+	return state + strconv.Itoa(speech.Current) + "/" + strconv.Itoa(len(speech.Paragraphs))
+}
+
+// Stop reading aloud the current paragraph.
+func (m *model) stopSpeechProcess() {
+	if m.Speech.Process != nil && m.Speech.Process.Process != nil {
+		m.Speech.Process.Process.Kill()
+	}
+	m.Speech.Process = nil
+}
+
+// Stop reading aloud, removing the paragraph highlight.
+func (m *model) stopSpeech() {
+	if m.Speech.Current == 0 {
+		return
+	}
+	m.stopSpeechProcess()
+	m.Speech = walgotSpeech{}
+	if m.SelectedID > 0 {
+		m.setDetailContent(m.SelectedID)
+	}
+}
+
+// Read aloud a paragraph of the entry read, scrolling to it if it isn't visible.
+func (m *model) speakParagraph(number int) tea.Cmd {
+	m.stopSpeechProcess()
+	m.Speech.Current = number
+	m.Speech.Paused = false
+
+	content := m.setDetailContent(m.SelectedID)
+	located := locateParagraphs(strings.Split(content, "\n"), m.Speech.Paragraphs)
+	if lines := located[number-1]; lines.Start >= 0 && (lines.Start < m.Viewport.YOffset || lines.End >= m.Viewport.YOffset+m.Viewport.Height) {
+		m.Viewport.SetYOffset(lines.Start - m.Viewport.Height/3)
+	}
+
+	process, cmd, err := speakCommand(m.SpeechCommand, m.Speech.Paragraphs[number-1])
+	if err != nil {
+		if m.DebugMode {
+			log.Println("Error while reading aloud")
+			log.Println(err)
+		}
+		m.stopSpeech()
+		m.Dialog.Message = "Couldn't read aloud with " + m.SpeechCommand
+		return nil
+	}
+	m.Speech.Process = process
+
+	return cmd
+}
+
+// Start reading aloud the entry read from the first visible paragraph,
+// or pause / resume reading.
+func (m *model) toggleSpeech() tea.Cmd {
+	if m.Speech.Current > 0 && !m.Speech.Paused {
+		m.stopSpeechProcess()
+		m.Speech.Paused = true
+		return nil
+	}
+	if m.Speech.Current > 0 {
+		return m.speakParagraph(m.Speech.Current)
+	}
+
+	paragraphs := getSpeechParagraphs(m.Entries[getSelectedEntryIndex(m.Entries, m.SelectedID)].Content)
+	if len(paragraphs) == 0 {
+		m.UpdateMessage = "Nothing to read aloud in this article"
+		return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	}
+	m.Speech = walgotSpeech{Paragraphs: paragraphs}
+
+	content, _, _ := getDetailViewportContent(m.SelectedID, m.Entries, m.getContentOptions())
+	first := 1
+	for i, lines := range locateParagraphs(strings.Split(content, "\n"), paragraphs) {
+		if lines.Start >= m.Viewport.YOffset {
+			first = i + 1
+			break
+		}
+	}
+
+	return m.speakParagraph(first)
+}
+
+// Manage the end of a paragraph read aloud, reading the next one.
+// Paragraphs stopped (paused, skipped) are ignored.
+func speechEnded(m *model, msg walgotSpeechMsg) tea.Cmd {
+	if msg.Process != m.Speech.Process || m.Speech.Current == 0 {
+		return nil
+	}
+	m.Speech.Process = nil
+
+	if msg.err != nil {
+		if m.DebugMode {
+			log.Println("Error while reading aloud")
+			log.Println(msg.err)
+		}
+		m.stopSpeech()
+		m.Dialog.Message = "Couldn't read aloud with " + m.SpeechCommand
+		return nil
+	}
+	if m.Speech.Current >= len(m.Speech.Paragraphs) {
+		m.stopSpeech()
+		m.UpdateMessage = "Finished reading aloud"
+		return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	}
+
+	return m.speakParagraph(m.Speech.Current + 1)
+}
+
+// Manage reading aloud keybind actions.
+func speechUpdate(action string, m *model) tea.Cmd {
+	if m.SpeechCommand == "" {
+		m.UpdateMessage = "No speech command configured (\"SpeechCommand\" config)"
+		return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	}
+
+	switch action {
+	case "speak":
+		return m.toggleSpeech()
+	case "speechNext", "speechPrevious":
+		if m.Speech.Current == 0 {
+			return nil
+		}
+		number := m.Speech.Current + 1
+		if action == "speechPrevious" {
+			number = m.Speech.Current - 1
+		}
+		if number >= 1 && number <= len(m.Speech.Paragraphs) {
+			return m.speakParagraph(number)
+		}
+	case "speechStop":
+		m.stopSpeech()
+	}
+
+	return nil
+}
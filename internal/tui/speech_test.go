@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetSpeechParagraphs(t *testing.T) {
+	content := `<h2>Title</h2><p>A <a href="https://example.org">link</a> here, and text.</p><p>---</p><ul><li>One</li><li>Two</li></ul>`
+	expected := []string{"Title", "A link here, and text.", "One Two"}
+
+	if paragraphs := getSpeechParagraphs(content); !reflect.DeepEqual(paragraphs, expected) {
+		t.Errorf("getSpeechParagraphs: expected %q, got %q", expected, paragraphs)
+	}
+}
+
+func TestSpeechWords(t *testing.T) {
+	var tests = []struct {
+		text     string
+		expected []string
+	}{
+		{"Hello, World!", []string{"hello", "world"}},
+		{"A link [1] <https://example.org> - done.", []string{"a", "link", "done"}},
+		{"\x1b[1m---\x1b[0m", nil},
+	}
+
+	for _, test := range tests {
+		if words := speechWords(stripStyles(test.text)); !reflect.DeepEqual(words, test.expected) {
+			t.Errorf("speechWords(%q): expected %q, got %q", test.text, test.expected, words)
+		}
+	}
+}
+
+func TestLocateParagraphs(t *testing.T) {
+	lines := []string{
+		"  \x1b[1mTitle\x1b[0m",
+		"",
+		"  A link [1] here,",
+		"  and text.",
+		"",
+		"  • One",
+		"  • Two",
+	}
+	paragraphs := []string{"Title", "A link here, and text.", "Missing paragraph", "One Two"}
+	expected := []walgotParagraphLines{{0, 0}, {2, 3}, {-1, -1}, {5, 6}}
+
+	if located := locateParagraphs(lines, paragraphs); !reflect.DeepEqual(located, expected) {
+		t.Errorf("locateParagraphs: expected %v, got %v", expected, located)
+	}
+}
+
+func TestSpeakCommand(t *testing.T) {
+	if _, _, err := speakCommand("  ", "Text"); err == nil {
+		t.Errorf("speakCommand: expected an error without command")
+	}
+}
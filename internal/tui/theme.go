@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"errors"
+	"os"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Symbols used to display entries status.
+type walgotStatusSymbols struct {
+	Unread  string
+	Starred string
+	Public  string
+	// Same width as other symbols, to keep status aligned:
+	Empty string
+}
+
+// Theme used to render walgot.
+type walgotTheme struct {
+	Name        string
+	Accent      lipgloss.Color
+	Border      lipgloss.Color
+	TableBorder lipgloss.Color
+	SelectedFg  lipgloss.Color
+	SelectedBg  lipgloss.Color
+	ButtonFg    lipgloss.Color
+	ButtonBg    lipgloss.Color
+	// No colors at all, selection is shown with reversed colors:
+	NoColor bool
+	Status  walgotStatusSymbols
+}
+
+// Built-in themes.
+var builtinThemes = map[string]config.WalgotTheme{
+	"dark": {
+		Accent:      "205",
+		Border:      "#874BFD",
+		TableBorder: "240",
+		SelectedFg:  "229",
+		SelectedBg:  "57",
+		ButtonFg:    "#FFF7DB",
+		ButtonBg:    "#888B7E",
+	},
+	"light": {
+		Accent:      "161",
+		Border:      "#5A2FC2",
+		TableBorder: "245",
+		SelectedFg:  "231",
+		SelectedBg:  "62",
+		ButtonFg:    "#FFFFFF",
+		ButtonBg:    "#5C5F54",
+	},
+	"high-contrast": {
+		Accent:      "11",
+		Border:      "15",
+		TableBorder: "15",
+		SelectedFg:  "0",
+		SelectedBg:  "11",
+		ButtonFg:    "0",
+		ButtonBg:    "15",
+	},
+	"no-color": {},
+}
+
+// Status symbols, emoji or ASCII.
+var (
+	emojiStatusSymbols = walgotStatusSymbols{Unread: "🆕", Starred: "⭐", Public: "🔗", Empty: "  "}
+	asciiStatusSymbols = walgotStatusSymbols{Unread: "N", Starred: "*", Public: "@", Empty: " "}
+)
+
+// Create the theme from configuration.
+// NO_COLOR environment variable (https://no-color.org) disables colors whatever the configured theme.
+func newTheme(c config.WalgotConfig) (walgotTheme, error) {
+	name := c.Theme
+	if name == "" {
+		name = "dark"
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		name = "no-color"
+	}
+
+	colors, ok := c.Themes[name]
+	if !ok {
+		if colors, ok = builtinThemes[name]; !ok {
+			return newThemeFromColors("dark", builtinThemes["dark"], c.ASCIIStatus), errors.New("unknown theme \"" + name + "\"")
+		}
+	} else {
+		// User themes only need to define colors they want to change:
+		colors = mergeThemeColors(colors, builtinThemes["dark"])
+	}
+
+	// Linux console can't display emoji:
+	ascii := c.ASCIIStatus || os.Getenv("TERM") == "linux"
+
+	return newThemeFromColors(name, colors, ascii), nil
+}
+
+// Fill the empty colors of a theme with the default ones.
+func mergeThemeColors(colors, defaults config.WalgotTheme) config.WalgotTheme {
+	fill := func(color *string, defaultColor string) {
+		if *color == "" {
+			*color = defaultColor
+		}
+	}
+	fill(&colors.Accent, defaults.Accent)
+	fill(&colors.Border, defaults.Border)
+	fill(&colors.TableBorder, defaults.TableBorder)
+	fill(&colors.SelectedFg, defaults.SelectedFg)
+	fill(&colors.SelectedBg, defaults.SelectedBg)
+	fill(&colors.ButtonFg, defaults.ButtonFg)
+	fill(&colors.ButtonBg, defaults.ButtonBg)
+
+	return colors
+}
+
+// Create theme from its colors.
+func newThemeFromColors(name string, colors config.WalgotTheme, ascii bool) walgotTheme {
+	status := emojiStatusSymbols
+	if ascii {
+		status = asciiStatusSymbols
+	}
+
+	return walgotTheme{
+		Name:        name,
+		Accent:      lipgloss.Color(colors.Accent),
+		Border:      lipgloss.Color(colors.Border),
+		TableBorder: lipgloss.Color(colors.TableBorder),
+		SelectedFg:  lipgloss.Color(colors.SelectedFg),
+		SelectedBg:  lipgloss.Color(colors.SelectedBg),
+		ButtonFg:    lipgloss.Color(colors.ButtonFg),
+		ButtonBg:    lipgloss.Color(colors.ButtonBg),
+		NoColor:     colors == config.WalgotTheme{},
+		Status:      status,
+	}
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"testing"
+
+	"git.bacardi55.io/bacardi55/walgot/internal/config"
+
+	"github.com/Strubbl/wallabago/v7"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestNewTheme(t *testing.T) {
+	var tests = []struct {
+		input            config.WalgotConfig
+		noColorEnv       string
+		expectedName     string
+		expectedAccent   lipgloss.Color
+		expectedBorder   lipgloss.Color
+		expectedNoColor  bool
+		expectedUnread   string
+		expectedIsErrNil bool
+	}{
+		{config.WalgotConfig{}, "", "dark", "205", "#874BFD", false, "🆕", true},
+		{config.WalgotConfig{Theme: "light", ASCIIStatus: true}, "", "light", "161", "#5A2FC2", false, "N", true},
+		{config.WalgotConfig{Theme: "no-color"}, "", "no-color", "", "", true, "🆕", true},
+		{config.WalgotConfig{Theme: "light"}, "1", "no-color", "", "", true, "🆕", true},
+		{
+			config.WalgotConfig{Theme: "mine", Themes: map[string]config.WalgotTheme{"mine": {Accent: "42"}}},
+			"",
+			"mine",
+			"42",
+			"#874BFD",
+			false,
+			"🆕",
+			true,
+		},
+		{config.WalgotConfig{Theme: "unknown"}, "", "dark", "205", "#874BFD", false, "🆕", false},
+	}
+
+	for _, test := range tests {
+		t.Setenv("NO_COLOR", test.noColorEnv)
+		t.Setenv("TERM", "xterm-256color")
+		result, e := newTheme(test.input)
+		if result.Name != test.expectedName {
+			t.Errorf("newTheme(%v): expectedName %v, got %v", test.input, test.expectedName, result.Name)
+		}
+		if result.Accent != test.expectedAccent {
+			t.Errorf("newTheme(%v): expectedAccent %v, got %v", test.input, test.expectedAccent, result.Accent)
+		}
+		if result.Border != test.expectedBorder {
+			t.Errorf("newTheme(%v): expectedBorder %v, got %v", test.input, test.expectedBorder, result.Border)
+		}
+		if result.NoColor != test.expectedNoColor {
+			t.Errorf("newTheme(%v): expectedNoColor %v, got %v", test.input, test.expectedNoColor, result.NoColor)
+		}
+		if result.Status.Unread != test.expectedUnread {
+			t.Errorf("newTheme(%v): expectedUnread %v, got %v", test.input, test.expectedUnread, result.Status.Unread)
+		}
+		isErrNil := (e == nil)
+		if isErrNil != test.expectedIsErrNil {
+			t.Errorf("newTheme(%v): expectedIsErrNil %v, got %v", test.input, test.expectedIsErrNil, isErrNil)
+		}
+	}
+}
+
+func TestGetEntryStatus(t *testing.T) {
+	var tests = []struct {
+		input    wallabago.Item
+		aligned  bool
+		expected string
+	}{
+		{wallabago.Item{IsArchived: 0, IsStarred: 1, IsPublic: true}, true, "N*@"},
+		{wallabago.Item{IsArchived: 1, IsStarred: 1}, true, " *"},
+		{wallabago.Item{IsArchived: 1, IsStarred: 0, IsPublic: true}, true, "  @"},
+		{wallabago.Item{IsArchived: 1, IsStarred: 0, IsPublic: true}, false, "@"},
+	}
+
+	for _, test := range tests {
+		result := getEntryStatus(&test.input, asciiStatusSymbols, test.aligned)
+		if test.expected != result {
+			t.Errorf("getEntryStatus(%v, %v): expected %q, got %q", test.input.ID, test.aligned, test.expected, result)
+		}
+	}
+}
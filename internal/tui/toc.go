@@ -0,0 +1,32 @@
+package tui
+
+// Retrieve the index of the heading of the section displayed at a line, -1 before the first heading.
+func getCurrentHeading(headings []walgotHeading, line int) int {
+	current := -1
+	for i, heading := range headings {
+		if heading.Line > line {
+			break
+		}
+		current = i
+	}
+
+	return current
+}
+
+// Retrieve the index of the heading of the section being read.
+// At the bottom of the entry, the last visible section is the one being read.
+func (m *model) getReadHeading() int {
+	line := m.Viewport.YOffset
+	if m.Viewport.AtBottom() {
+		line += m.Viewport.Height - 1
+	}
+
+	return getCurrentHeading(m.Headings, line)
+}
+
+// Scroll to a heading of the entry read, displayed at the top of the viewport.
+func (m *model) gotoHeading(index int) {
+	if index >= 0 && index < len(m.Headings) {
+		m.Viewport.SetYOffset(m.Headings[index].Line)
+	}
+}
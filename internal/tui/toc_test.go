@@ -0,0 +1,32 @@
+package tui
+
+import "testing"
+
+func TestGetCurrentHeading(t *testing.T) {
+	headings := []walgotHeading{
+		{Level: 1, Text: "Title", Line: 2},
+		{Level: 2, Text: "Section", Line: 10},
+		{Level: 2, Text: "Other section", Line: 25},
+	}
+
+	var tests = []struct {
+		line     int
+		expected int
+	}{
+		{0, -1},
+		{2, 0},
+		{9, 0},
+		{10, 1},
+		{100, 2},
+	}
+
+	for _, test := range tests {
+		if current := getCurrentHeading(headings, test.line); current != test.expected {
+			t.Errorf("getCurrentHeading(%v): expected %v, got %v", test.line, test.expected, current)
+		}
+	}
+
+	if current := getCurrentHeading(nil, 10); current != -1 {
+		t.Errorf("getCurrentHeading(): expected -1 without headings, got %v", current)
+	}
+}
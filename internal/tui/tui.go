@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"image"
 	"log"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -34,9 +36,33 @@ type walgotTableFilters struct {
 	Starred  bool
 	Unread   bool
 	Public   bool
-	Search   string
+	// Entries wallabag couldn't retrieve content for:
+	Failed bool
+	// Entries with a short or long reading time:
+	Short bool
+	Long  bool
+	// Entries with all these tags:
+	Tags   []string
+	Domain string
+	Search string
 }
 
+// Domain of entries, with its number of entries.
+type walgotDomain struct {
+	Name     string
+	NbUnread int
+	NbTotal  int
+}
+
+// Reading time limits (in minutes) for short and long reading time filters.
+const (
+	shortReadingTime = 5
+	longReadingTime  = 20
+)
+
+// Minimum terminal width (in columns) to display the split view.
+const splitViewMinWidth = 120
+
 // TableView Sort options
 type walgotTableSorts struct {
 	Field string
@@ -55,6 +81,111 @@ type walgotDialog struct {
 	TextInput textinput.Model
 	ShowInput bool
 	Action    string
+	// Entry the dialog is about, if any (eg: duplicate):
+	EntryID int
+	// Link the dialog is about, if any (eg: save link):
+	Link string
+}
+
+// Add entry form fields, in focus order:
+const (
+	addFormURL = iota
+	addFormTitle
+	addFormTags
+	addFormArchive
+	addFormStarred
+	addFormContent
+	addFormNbFields
+)
+
+// Add entry form:
+type walgotAddForm struct {
+	// URL, title and tags text inputs:
+	Inputs []textinput.Model
+	// Optional content, for paywalled pages:
+	Content textarea.Model
+	Archive bool
+	Starred bool
+	// Index of the focused field:
+	Focus int
+	// Tags already used, for autocompletion:
+	KnownTags []string
+}
+
+// Edit entry form fields, in focus order:
+const (
+	editFormTitle = iota
+	editFormTags
+	editFormLanguage
+	editFormOriginURL
+	editFormPublishedAt
+	editFormAuthors
+	editFormNbFields
+)
+
+// Entry metadata that can be edited:
+type walgotEntryMetadata struct {
+	Title       string
+	Tags        string
+	Language    string
+	OriginURL   string
+	PublishedAt string
+	Authors     string
+}
+
+// Edit entry form:
+type walgotEditForm struct {
+	EntryID int
+	// One text input per field:
+	Inputs []textinput.Model
+	// Index of the focused field:
+	Focus int
+	// Tags already used, for autocompletion:
+	KnownTags []string
+}
+
+// Fuzzy finder overlay:
+type walgotFinder struct {
+	Input   textinput.Model
+	Results []walgotFuzzyResult
+	Cursor  int
+}
+
+// Links list overlay, for the entry read:
+type walgotLinkList struct {
+	Input   textinput.Model
+	Links   []walgotLink
+	Results []walgotLinkResult
+	Cursor  int
+}
+
+// Search within the entry read:
+type walgotContentSearch struct {
+	Term string
+	// Number of the focused match (0 if none), and number of matches:
+	Current int
+	Count   int
+}
+
+// Command palette overlay:
+type walgotPalette struct {
+	Input       textinput.Model
+	Suggestions []walgotPaletteSuggestion
+	Cursor      int
+	// View the palette has been opened from, where commands are run:
+	View  string
+	Error string
+	// Commands run during the session, most recent last:
+	History      []string
+	HistoryIndex int
+}
+
+// Clipboard watcher:
+type walgotClipboard struct {
+	Watch bool
+	// Last clipboard content seen, to only offer new URLs:
+	LastContent string
+	Initialized bool
 }
 
 // Walgot error message:
@@ -67,8 +198,17 @@ type wallabagoResponseErrorMsg struct {
 type model struct {
 	// Sub models related:
 	Table         table.Model
+	DomainTable   table.Model
+	ViewTable     table.Model
 	Viewport      viewport.Model
 	Dialog        walgotDialog
+	AddForm       walgotAddForm
+	EditForm      walgotEditForm
+	Clipboard     walgotClipboard
+	Finder        walgotFinder
+	Palette       walgotPalette
+	LinkList      walgotLinkList
+	ImageList     walgotLinkList
 	Spinner       spinner.Model
 	UpdateMessage string
 	// Tui Status related
@@ -77,30 +217,113 @@ type model struct {
 	CurrentView string
 	Options     walgotTableOptions
 	// Wallabag(o) related:
-	Entries              []wallabago.Item
+	Entries []wallabago.Item
+	// Reading progress (in %) per entry ID, for this session:
+	ReadingProgress      map[int]int
 	SelectedID           int
 	TotalEntriesOnServer int
+	// Entry previewed in split view, when no entry is read:
+	PreviewID int
+	// Number of the link focused in the entry read (0 if none):
+	LinkFocus int
+	// Search within the entry read:
+	ContentSearch walgotContentSearch
+	// Reading aloud of the entry read:
+	Speech walgotSpeech
+	// Share menu of the selected entry:
+	Share walgotShare
+	// Headings of the entry read or previewed, and cursor of the table of contents:
+	Headings  []walgotHeading
+	TOCCursor int
+	// Content of the entry read or previewed, without the focused link highlight, and its links:
+	Content string
+	Links   []walgotLink
+	// Images downloaded for inline rendering, per URL (nil if not downloaded):
+	Images map[string]image.Image
 	// Configs
+	KeyMap              walgotKeyMap
+	Theme               walgotTheme
+	ListColumns         []walgotColumn
+	DateFormat          string
+	SavedViews          []walgotSavedView
+	ViewsFile           string
+	SplitView           bool
+	InlineImages        bool
+	ImageViewer         string
+	ImageViewerTerminal bool
+	ViaTag              bool
+	Reader              walgotReader
+	ArchiveOnNext       bool
+	SpeechCommand       string
+	ShareCommand        string
 	NbEntriesPerAPICall int
 	TermSize            termSize
 	DebugMode           bool
 }
 
 // NewModel returns default model for walgot.
-func NewModel(config config.WalgotConfig) model {
+// An error is returned if the configured keybinds, theme, columns, views, inline images mode or reader settings are not valid.
+func NewModel(config config.WalgotConfig) (model, error) {
+	keyMap, err := newKeyMap(config.Keybinds)
+	theme, themeErr := newTheme(config)
+	if err == nil {
+		err = themeErr
+	}
+	columns, columnsErr := newColumns(config.Columns)
+	if err == nil {
+		err = columnsErr
+	}
+	dateFormat := config.DateFormat
+	if dateFormat == "" {
+		dateFormat = defaultDateFormat
+	}
+	sorts := walgotTableSorts{
+		Field: "created",
+		Order: "desc",
+	}
+	savedViews, viewsErr := newSavedViews(config.Views, sorts, columns)
+	if err == nil {
+		err = viewsErr
+	}
+	inlineImages, imagesErr := newInlineImages(config.InlineImages)
+	if err == nil {
+		err = imagesErr
+	}
+	reader, readerErr := newReader(config.Reader)
+	if err == nil {
+		err = readerErr
+	}
+
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.
 		NewStyle().
-		Foreground(lipgloss.Color("205"))
+		Foreground(theme.Accent)
 
-	return model{
+	m := model{
 		SelectedID:           0,
 		Ready:                false,
 		Reloading:            true,
 		CurrentView:          "list",
 		TotalEntriesOnServer: 0,
 		Spinner:              s,
+		KeyMap:               keyMap,
+		Theme:                theme,
+		ListColumns:          columns,
+		DateFormat:           dateFormat,
+		SavedViews:           savedViews,
+		ViewsFile:            config.ViewsFile,
+		SplitView:            config.SplitView,
+		InlineImages:         inlineImages,
+		ImageViewer:          config.ImageViewer,
+		ImageViewerTerminal:  config.ImageViewerTerminal,
+		ViaTag:               config.ViaTag,
+		Reader:               reader,
+		ArchiveOnNext:        config.ArchiveOnNext,
+		SpeechCommand:        config.SpeechCommand,
+		ShareCommand:         config.ShareCommand,
+		Images:               map[string]image.Image{},
+		ReadingProgress:      map[int]int{},
 		NbEntriesPerAPICall:  config.NbEntriesPerAPICall,
 		DebugMode:            config.DebugMode,
 		Dialog: walgotDialog{
@@ -109,18 +332,143 @@ func NewModel(config config.WalgotConfig) model {
 			TextInput: textinput.New(),
 			Action:    "",
 		},
+		AddForm: newAddForm(),
+		Clipboard: walgotClipboard{
+			Watch: config.ClipboardWatcher,
+		},
 		Options: walgotTableOptions{
 			Filters: walgotTableFilters{
 				Unread:  config.DefaultListViewUnread,
 				Starred: config.DefaultListViewStarred,
 				Public:  config.DefaultListViewPublic,
 			},
-			Sorts: walgotTableSorts{
-				Field: "created",
-				Order: "desc",
-			},
+			Sorts: sorts,
 		},
 	}
+
+	// Startup view:
+	if config.DefaultView != "" {
+		if index := getSavedViewIndex(savedViews, config.DefaultView); index >= 0 {
+			m.applySavedView(savedViews[index])
+		} else if err == nil {
+			err = errors.New("unknown default view \"" + config.DefaultView + "\"")
+		}
+	}
+
+	return m, err
+}
+
+// Returns the fuzzy finder, with all entries as results.
+func newFinder(entries []wallabago.Item) walgotFinder {
+	input := textinput.New()
+	input.Placeholder = "Find by title, domain or tags"
+	input.Width = 40
+	input.Focus()
+
+	return walgotFinder{
+		Input:   input,
+		Results: fuzzyFindEntries(entries, ""),
+	}
+}
+
+// Returns the links list overlay, with all links as results.
+func newLinkList(links []walgotLink) walgotLinkList {
+	input := textinput.New()
+	input.Placeholder = "Filter by text or URL"
+	input.Width = 40
+	input.Focus()
+
+	return walgotLinkList{
+		Input:   input,
+		Links:   links,
+		Results: fuzzyFindLinks(links, ""),
+	}
+}
+
+// Returns the images list overlay, images being filtered like links by their alternative text or URL.
+func newImageList(images []walgotImage) walgotLinkList {
+	links := make([]walgotLink, len(images))
+	for i, img := range images {
+		links[i] = walgotLink{URL: img.URL, Text: img.Alt}
+	}
+	list := newLinkList(links)
+	list.Input.Placeholder = "Filter by alternative text or URL"
+
+	return list
+}
+
+// Returns the command palette for the view, keeping the command history.
+func newPalette(keyMap walgotKeyMap, view string, history []string) walgotPalette {
+	input := textinput.New()
+	input.Placeholder = "Command or action, eg: sort title asc"
+	input.Prompt = ":"
+	input.Width = 40
+	input.Focus()
+
+	return walgotPalette{
+		Input:        input,
+		Suggestions:  getPaletteSuggestions(keyMap, view, ""),
+		View:         view,
+		History:      history,
+		HistoryIndex: len(history),
+	}
+}
+
+// Returns an empty add entry form.
+func newAddForm() walgotAddForm {
+	inputs := make([]textinput.Model, addFormTags+1)
+	placeholders := []string{"URL", "Title (optional)", "Tags, comma separated (optional)"}
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].Placeholder = placeholders[i]
+		inputs[i].Width = 40
+	}
+
+	content := textarea.New()
+	content.Placeholder = "Article content, for paywalled pages (optional)"
+	content.ShowLineNumbers = false
+	content.SetWidth(50)
+	content.SetHeight(4)
+
+	return walgotAddForm{
+		Inputs:  inputs,
+		Content: content,
+	}
+}
+
+// Returns an edit form pre-filled with the entry metadata.
+func newEditForm(entry *wallabago.Item, knownTags []string) walgotEditForm {
+	metadata := getEntryMetadata(entry)
+	values := []string{
+		metadata.Title,
+		metadata.Tags,
+		metadata.Language,
+		metadata.OriginURL,
+		metadata.PublishedAt,
+		metadata.Authors,
+	}
+	placeholders := []string{
+		"Title",
+		"Tags, comma separated",
+		"Language (eg: en)",
+		"Original URL",
+		"Published date (YYYY-MM-DD)",
+		"Authors, comma separated",
+	}
+
+	inputs := make([]textinput.Model, editFormNbFields)
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].Placeholder = placeholders[i]
+		inputs[i].Width = 40
+		inputs[i].SetValue(values[i])
+	}
+
+	return walgotEditForm{
+		EntryID:   entry.ID,
+		Inputs:    inputs,
+		KnownTags: knownTags,
+	}
 }
 
 // Response message for number of entities from Wallabago
@@ -140,6 +488,21 @@ type wallabagoResponseClearMsg bool
 // Add entry message.
 type wallabagoResponseAddEntryMsg struct {
 	Entry wallabago.Item
+	// Error while checking if the entry already existed, not blocking:
+	existsErr error
+}
+
+// Reloaded entries message.
+type wallabagoResponseReloadEntriesMsg struct {
+	Entries []wallabago.Item
+	// Errors of entries that still couldn't be reloaded:
+	errs []error
+}
+
+// Entry already saved on wallabag message.
+type wallabagoResponseEntryExistsMsg struct {
+	URL string
+	ID  int
 }
 
 // Delete entry message.
@@ -148,6 +511,18 @@ type wallabagoResponseDeleteEntryMsg int
 // Selected row in table list Message.
 type walgotSelectRowMsg int
 
+// Clipboard content checked message, with the URL copied (empty if none).
+type walgotClipboardTickMsg string
+
+// Clipboard read to pre-fill the add form message, with the URL copied (empty if none).
+type walgotClipboardURLMsg string
+
+// View saved in views file message.
+type walgotViewSavedMsg struct {
+	Name string
+	err  error
+}
+
 // Search for an entry message.
 type walgotSearchEntryMsg string
 
@@ -192,11 +567,11 @@ func requestWallabagEntries(nbArticles, nbEntriesPerAPICall int, sortField, sort
 	}
 }
 
-// Callback for updating an entry status via API.
-func requestWallabagEntryUpdate(entryID, archive, starred, public int) tea.Cmd {
+// Callback for updating an entry via API.
+func requestWallabagEntryUpdate(entryID int, fields map[string]string) tea.Cmd {
 	return func() tea.Msg {
 		// Send PATCH via API:
-		r, err := api.UpdateEntry(entryID, archive, starred, public)
+		r, err := api.UpdateEntry(entryID, fields)
 		if err != nil {
 			return wallabagoResponseErrorMsg{
 				message:        "Error:\n Couldn't update the selected entry",
@@ -217,16 +592,46 @@ func requestWallabagEntryUpdate(entryID, archive, starred, public int) tea.Cmd {
 	}
 }
 
+// Callback for editing an entry metadata via API.
+func requestWallabagEntryEdit(entryID int, fields map[string]string, removedTagIDs []int) tea.Cmd {
+	return func() tea.Msg {
+		// PATCH can only add tags, removed ones need to be deleted first:
+		for _, tagID := range removedTagIDs {
+			if err := api.DeleteEntryTag(entryID, tagID); err != nil {
+				return wallabagoResponseErrorMsg{
+					message:        "Error:\n Couldn't remove tag from the selected entry",
+					wallabagoError: err,
+				}
+			}
+		}
+
+		return requestWallabagEntryUpdate(entryID, fields)()
+	}
+}
+
 // Callback for adding an entry via API.
-func requestWallabagAddEntry(url string) tea.Cmd {
+func requestWallabagAddEntry(entry api.NewEntry) tea.Cmd {
 	return func() tea.Msg {
+		url := entry.URL
 		if !isValidURL(url) {
 			return wallabagoResponseErrorMsg{
 				message:        "Error:\n Invalid URL",
 				wallabagoError: errors.New("invalid URL given"),
 			}
 		}
-		r, err := api.AddEntry(url)
+		// Check first if the URL isn't already saved on wallabag:
+		// Not blocking, older wallabag instances might not support it:
+		exists, existsErr := api.EntriesExist([]string{url, normalizeURL(url)})
+		for _, id := range exists {
+			if id > 0 {
+				return wallabagoResponseEntryExistsMsg{
+					URL: url,
+					ID:  id,
+				}
+			}
+		}
+
+		r, err := api.AddEntry(entry)
 		if err != nil {
 			return wallabagoResponseErrorMsg{
 				message:        "Error:\n Couldn't add the entry",
@@ -235,7 +640,40 @@ func requestWallabagAddEntry(url string) tea.Cmd {
 		}
 
 		return wallabagoResponseAddEntryMsg{
-			Entry: r,
+			Entry:     r,
+			existsErr: existsErr,
+		}
+	}
+}
+
+// Callback for reloading entries content via API.
+func requestWallabagEntriesReload(ids []int) tea.Cmd {
+	return func() tea.Msg {
+		var entries []wallabago.Item
+		var errs []error
+		for _, id := range ids {
+			item, err := api.ReloadEntry(id)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			entries = append(entries, item)
+		}
+
+		if len(entries) == 0 {
+			err := errors.New("no entry reloaded")
+			if len(errs) > 0 {
+				err = errs[0]
+			}
+			return wallabagoResponseErrorMsg{
+				message:        "Error:\n Wallabag couldn't reload the content",
+				wallabagoError: err,
+			}
+		}
+
+		return wallabagoResponseReloadEntriesMsg{
+			Entries: entries,
+			errs:    errs,
 		}
 	}
 }
@@ -262,15 +700,45 @@ func selectEntryCommand(selectedRowID int) tea.Cmd {
 	}
 }
 
+// Callback for checking the clipboard content regularly.
+// The clipboard is read by the command, reading it can take time (eg: xclip).
+func watchClipboardCommand() tea.Cmd {
+	return tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
+		return walgotClipboardTickMsg(getURLFromClipboard())
+	})
+}
+
+// Command reading the URL copied, to pre-fill the add form.
+func readClipboardCommand() tea.Cmd {
+	return func() tea.Msg {
+		return walgotClipboardURLMsg(getURLFromClipboard())
+	}
+}
+
+// Command saving a view in the views file.
+func saveViewCommand(viewsFile string, view config.WalgotView) tea.Cmd {
+	return func() tea.Msg {
+		return walgotViewSavedMsg{
+			Name: view.Name,
+			err:  config.SaveView(viewsFile, view),
+		}
+	}
+}
+
 // ** Model related methods ** //
 // Init method.
 func (m model) Init() tea.Cmd {
 	//wallabago.ReadConfig(m.WallabagConfig )
 
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		requestWallabagNbEntries,
 		m.Spinner.Tick,
-	)
+	}
+	if m.Clipboard.Watch {
+		cmds = append(cmds, watchClipboardCommand())
+	}
+
+	return tea.Batch(cmds...)
 }
 
 // Update method.
@@ -281,9 +749,10 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	if msg, ok := msg.(tea.KeyMsg); ok {
 		// C-c to kill the app.
-		if msg.String() == "ctrl+c" {
+		if m.KeyMap.action("global", msg) == "forceQuit" {
+			m.stopSpeechProcess()
 			return m, tea.Quit
-		} else if msg.String() == "?" && !m.Reloading {
+		} else if m.KeyMap.action("global", msg) == "help" && !m.Reloading && m.Dialog.Message == "" && !isTextInputView(m.CurrentView) {
 			m.CurrentView = "help"
 			return m, nil
 		}
@@ -305,19 +774,138 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
 			return wallabagoResponseClearMsg(true)
 		})
+	} else if v, ok := msg.(wallabagoResponseReloadEntriesMsg); ok {
+		if m.DebugMode {
+			for _, err := range v.errs {
+				log.Println("Error while reloading entry")
+				log.Println(err)
+			}
+		}
+		// Reloaded entries need to be refreshed in the model:
+		reloadedEntriesInModel(&m, v)
+		return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	} else if v, ok := msg.(walgotViewSavedMsg); ok {
+		if v.err != nil {
+			if m.DebugMode {
+				log.Println("Error while saving view")
+				log.Println(v.err)
+			}
+			m.Dialog.Message = "Couldn't save view " + v.Name + " in views file"
+			return m, nil
+		}
+		m.UpdateMessage = "View " + v.Name + " saved"
+		return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	} else if v, ok := msg.(walgotImagesMsg); ok {
+		for u, img := range v.Images {
+			m.Images[u] = img
+		}
+		// Refresh content if the entry is being read or previewed:
+		if v.EntryID == m.SelectedID || (m.SelectedID == 0 && v.EntryID == m.PreviewID) {
+			m.setDetailContent(v.EntryID)
+		}
+		return m, nil
+	} else if v, ok := msg.(walgotImageViewerMsg); ok {
+		if v.err != nil {
+			if m.DebugMode {
+				log.Println("Error while opening image")
+				log.Println(v.err)
+			}
+			m.Dialog.Message = "Couldn't open image with " + m.ImageViewer
+		}
+		return m, nil
+	} else if v, ok := msg.(walgotEntryPublishedMsg); ok {
+		// Entry published from the share menu, its public link can be copied:
+		updatedEntryInModel(&m, v.Entry)
+		publicURL := getPublicURL(&v.Entry)
+		if publicURL == "" {
+			m.Dialog.Message = "Couldn't retrieve the public link of the article"
+			return m, nil
+		}
+		return m, m.copySharedText(publicURL, "Article published, public link copied")
+	} else if v, ok := msg.(walgotShareSentMsg); ok {
+		if v.err != nil {
+			if m.DebugMode {
+				log.Println("Error while sharing article")
+				log.Println(v.err)
+			}
+			m.UpdateMessage = ""
+			m.Dialog.Message = "Couldn't share article with " + m.ShareCommand
+			return m, nil
+		}
+		m.UpdateMessage = "Article sent"
+		return m, tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
+			return wallabagoResponseClearMsg(true)
+		})
+	} else if v, ok := msg.(walgotExternalMsg); ok {
+		if v.err != nil {
+			if m.DebugMode {
+				log.Println("Error while opening article")
+				log.Println(v.err)
+			}
+			m.Dialog.Message = "Couldn't open article with " + v.Command
+		}
+		return m, nil
+	} else if v, ok := msg.(wallabagoResponseAddEntryMsg); ok {
+		if v.existsErr != nil && m.DebugMode {
+			log.Println("Couldn't check if entry already exists")
+			log.Println(v.existsErr)
+		}
+		// Entries can be added from any view (eg: links of the entry read):
+		return m, addedEntryInModel(&m, v.Entry)
+	} else if v, ok := msg.(walgotSpeechMsg); ok {
+		// Read the next paragraph aloud:
+		return m, speechEnded(&m, v)
+	} else if v, ok := msg.(wallabagoResponseEntryExistsMsg); ok {
+		// Entry already saved, offer to open it instead:
+		showDuplicateEntryDialog(&m, v.ID)
 	} else if v, ok := msg.(wallabagoResponseClearMsg); ok && bool(v) {
 		// Clear update message
 		m.UpdateMessage = ""
+	} else if v, ok := msg.(walgotClipboardTickMsg); ok {
+		// Offer to save newly copied URLs:
+		watchClipboard(&m, string(v))
+		return m, watchClipboardCommand()
+	} else if v, ok := msg.(walgotClipboardURLMsg); ok {
+		// Pre-fill the add form URL, unless one was typed meanwhile:
+		if m.CurrentView == "add" && v != "" && m.AddForm.Inputs[addFormURL].Value() == "" {
+			m.AddForm.Inputs[addFormURL].SetValue(string(v))
+			m.AddForm.Inputs[addFormURL].CursorEnd()
+		}
+		return m, nil
 	} else if v, ok := msg.(walgotSelectRowMsg); ok {
 		// This needs to happen before sending to the sub update function.
 		m.SelectedID = int(v)
 	}
 
-	// Priority order: dialog > help > detail > list.
+	// Priority order: dialog > help > domains > views > finder > palette > links > toc > forms > detail > list.
 	if m.Dialog.Message != "" {
 		return updateDialogView(msg, &m)
 	} else if m.CurrentView == "help" {
 		return updateHelpView(msg, m)
+	} else if m.CurrentView == "domains" {
+		return updateDomainsView(msg, m)
+	} else if m.CurrentView == "views" {
+		return updateSavedViewsView(msg, m)
+	} else if m.CurrentView == "finder" {
+		return updateFinderView(msg, &m)
+	} else if m.CurrentView == "palette" {
+		return updatePaletteView(msg, &m)
+	} else if m.CurrentView == "links" {
+		return updateLinksView(msg, &m)
+	} else if m.CurrentView == "images" {
+		return updateImagesView(msg, &m)
+	} else if m.CurrentView == "toc" {
+		return updateTOCView(msg, &m)
+	} else if m.CurrentView == "share" {
+		return updateShareView(msg, &m)
+	} else if m.CurrentView == "add" {
+		return updateAddFormView(msg, &m)
+	} else if m.CurrentView == "edit" {
+		return updateEditFormView(msg, &m)
 	}
 
 	// Now send to the right sub-update function:
@@ -327,6 +915,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return updateListView(msg, m)
 }
 
+// Check if the view is made of text inputs, where only control keys can be used.
+func isTextInputView(view string) bool {
+	return view == "add" || view == "edit" || view == "finder" || view == "palette" || view == "links" || view == "images"
+}
+
 // View method.
 func (m model) View() string {
 	return fmt.Sprintf("%s\n%s\n%s", m.headerView(), m.mainView(), m.footerView())
@@ -1,19 +1,22 @@
 package tui
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/url"
 	"os/exec"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Strubbl/wallabago/v7"
 	"github.com/atotto/clipboard"
 	"github.com/k3a/html2text"
-	"github.com/muesli/reflow/wordwrap"
-	"github.com/muesli/reflow/wrap"
 )
 
 // Open link in default browser.
@@ -39,9 +42,30 @@ func openLinkInBrowser(url string) error {
 }
 
 // Copy link.
+// The clipboard watcher doesn't offer to save links copied by walgot.
 // TODO: test on macOS or windows…
-func copyLinkToClipboard(url string) error {
-	return clipboard.WriteAll(url)
+func (m *model) copyLinkToClipboard(url string) error {
+	if err := clipboard.WriteAll(url); err != nil {
+		return err
+	}
+	m.Clipboard.LastContent = url
+
+	return nil
+}
+
+// Retrieve the clipboard content if it is a URL, empty string otherwise.
+func getURLFromClipboard() string {
+	content, err := clipboard.ReadAll()
+	if err != nil {
+		return ""
+	}
+
+	content = strings.TrimSpace(content)
+	if !isValidURL(content) || !strings.Contains(content, "://") || strings.ContainsAny(content, " \n\t") {
+		return ""
+	}
+
+	return content
 }
 
 // Retrieve index of the selected entry in model.Entries
@@ -56,16 +80,184 @@ func getSelectedEntryIndex(entries []wallabago.Item, id int) int {
 	return entryIndex
 }
 
-// Retrieve the article content, in clean and wrap text.
-func getSelectedEntryContent(entries []wallabago.Item, index, maxWidth int) string {
-	content := getContentForViewport(entries[index].Content)
+// Retrieve the article content, rendered and laid out with the reader settings.
+// Downloaded images are displayed above their placeholder, the paragraph read aloud
+// and search matches are highlighted.
+// Headings are returned with their line, for navigation, and links numbered as in the content.
+func getSelectedEntryContent(entries []wallabago.Item, index int, options walgotContentOptions) (string, []walgotHeading, []walgotLink) {
+	contentHTML, images := getEntryContentAndImages(&entries[index])
+	reader := options.Reader
+	w, _ := reader.getWidths(options.Width)
 
-	w := 72
-	if maxWidth < w {
-		w = maxWidth - 2
+	content, links := renderContent(contentHTML, images, w, options.Style)
+	// Headings text is retrieved before line spacing:
+	headings := getContentHeadings(content)
+	content = spaceLines(breakLongLines(content, w, reader.Hyphenation), reader.LineSpacing)
+	content = insertInlineImages(content, images, options.Images, w)
+	content = locateHeadings(content, headings)
+	if options.SpokenParagraph > 0 {
+		located := locateParagraphs(strings.Split(content, "\n"), options.SpeechParagraphs)
+		lines := located[options.SpokenParagraph-1]
+		content = highlightContentLines(content, lines.Start, lines.End, options.Highlight)
+	}
+	if options.Search != "" {
+		content = highlightContentMatches(content, options.Search, options.SearchMatch, options.MatchHighlight, options.Highlight)
 	}
 
-	return wrap.String(wordwrap.String(content, w), w)
+	return addLeftMargin(content, reader.MarginLeft), headings, links
+}
+
+// Check if wallabag couldn't retrieve the content of the entry.
+func isContentFailed(entry *wallabago.Item) bool {
+	return strings.TrimSpace(entry.Content) == "" ||
+		strings.Contains(entry.Content, "wallabag can't retrieve contents for this article")
+}
+
+// Retrieve IDs of entries wallabag couldn't retrieve the content for.
+func getFailedEntryIDs(entries []wallabago.Item) []int {
+	var ids []int
+	for i := 0; i < len(entries); i++ {
+		if isContentFailed(&entries[i]) {
+			ids = append(ids, entries[i].ID)
+		}
+	}
+
+	return ids
+}
+
+// Retrieve entry tags, as displayed.
+func getEntryTagsText(entry *wallabago.Item) string {
+	var tags []string
+	for _, t := range entry.Tags {
+		tags = append(tags, t.Label)
+	}
+
+	return strings.Join(tags, ", ")
+}
+
+// Check if an entry has a tag (case insensitive).
+func hasTag(entry *wallabago.Item, tag string) bool {
+	for _, t := range entry.Tags {
+		if strings.EqualFold(t.Label, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Retrieve domains of entries, with the most used first.
+// Entries without domain are ignored.
+func getDomains(entries []wallabago.Item) []walgotDomain {
+	var domains []walgotDomain
+	indexes := map[string]int{}
+	for i := 0; i < len(entries); i++ {
+		name := entries[i].DomainName
+		if name == "" {
+			continue
+		}
+		index, ok := indexes[name]
+		if !ok {
+			index = len(domains)
+			indexes[name] = index
+			domains = append(domains, walgotDomain{Name: name})
+		}
+		domains[index].NbTotal++
+		if entries[i].IsArchived == 0 {
+			domains[index].NbUnread++
+		}
+	}
+
+	sort.SliceStable(domains, func(i, j int) bool {
+		if domains[i].NbTotal != domains[j].NbTotal {
+			return domains[i].NbTotal > domains[j].NbTotal
+		}
+		return domains[i].Name < domains[j].Name
+	})
+
+	return domains
+}
+
+// Retrieve the number of displayed entries and their total reading time.
+func getDisplayedReadingTime(entries []wallabago.Item, filters walgotTableFilters) (int, int) {
+	nb, readingTime := 0, 0
+	for i := 0; i < len(entries); i++ {
+		if isEntryDisplayed(&entries[i], filters) {
+			nb++
+			readingTime += entries[i].ReadingTime
+		}
+	}
+
+	return nb, readingTime
+}
+
+// Retrieve the ID of the entry displayed at a position of the list.
+// Returns 0 if there is no entry at this position.
+func getDisplayedEntryID(entries []wallabago.Item, filters walgotTableFilters, position int) int {
+	for i := 0; i < len(entries); i++ {
+		if !isEntryDisplayed(&entries[i], filters) {
+			continue
+		}
+		if position == 0 {
+			return entries[i].ID
+		}
+		position--
+	}
+
+	return 0
+}
+
+// Retrieve the ID of the entry selected in the list, 0 if the list is empty.
+// The table selected row can't be used, it panics without rows.
+func (m *model) getListSelectedID() int {
+	return getDisplayedEntryID(m.Entries, m.Options.Filters, m.Table.Cursor())
+}
+
+// Retrieve the position of an entry in the list, -1 if it isn't displayed.
+func getDisplayedEntryPosition(entries []wallabago.Item, filters walgotTableFilters, id int) int {
+	position := 0
+	for i := 0; i < len(entries); i++ {
+		if !isEntryDisplayed(&entries[i], filters) {
+			continue
+		}
+		if entries[i].ID == id {
+			return position
+		}
+		position++
+	}
+
+	return -1
+}
+
+// Retrieve the ID of the entry displayed in the list after (or before, going backward) an entry.
+// The entry itself may not be displayed anymore (eg: archived while only unread entries are displayed).
+// Returns 0 if there is no such entry.
+func getAdjacentEntryID(entries []wallabago.Item, filters walgotTableFilters, id int, backward bool) int {
+	index := getSelectedEntryIndex(entries, id)
+	if index < 0 {
+		return 0
+	}
+
+	step := 1
+	if backward {
+		step = -1
+	}
+	for i := index + step; i >= 0 && i < len(entries); i += step {
+		if isEntryDisplayed(&entries[i], filters) {
+			return entries[i].ID
+		}
+	}
+
+	return 0
+}
+
+// Format a reading time in minutes, eg: "25 min" or "1h05".
+func formatReadingTime(minutes int) string {
+	if minutes < 60 {
+		return strconv.Itoa(minutes) + " min"
+	}
+
+	return fmt.Sprintf("%dh%02d", minutes/60, minutes%60)
 }
 
 // Calculate the number of API call needed to retrieve all articles.
@@ -103,11 +295,237 @@ func isValidURL(u string) bool {
 	return true
 }
 
-func getContentForViewport(contentHTML string) string {
+// Query parameters used for tracking, removed before comparing URLs.
+var trackingQueryParams = []string{
+	"fbclid",
+	"gclid",
+	"dclid",
+	"msclkid",
+	"mc_cid",
+	"mc_eid",
+	"igshid",
+	"yclid",
+	"_hsenc",
+	"_hsmi",
+}
+
+// Normalize a URL so that two links to the same article can be compared:
+// lowercase scheme and host, no fragment, no tracking parameters
+// and no trailing slash.
+func normalizeURL(u string) string {
+	parsed, err := url.Parse(strings.TrimSpace(u))
+	if err != nil || parsed.Host == "" {
+		return strings.TrimSpace(u)
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.Fragment = ""
+
+	query := parsed.Query()
+	for param := range query {
+		if strings.HasPrefix(strings.ToLower(param), "utm_") {
+			query.Del(param)
+		}
+	}
+	for _, param := range trackingQueryParams {
+		query.Del(param)
+	}
+	parsed.RawQuery = query.Encode()
+
+	parsed.Path = strings.TrimRight(parsed.Path, "/")
+	parsed.RawPath = ""
+
+	return parsed.String()
+}
+
+// Hash a URL the same way wallabag does for hashed_url and hashed_given_url.
+func hashURL(u string) string {
+	h := sha1.Sum([]byte(u))
+	return hex.EncodeToString(h[:])
+}
+
+// Retrieve index of an already loaded entry matching the given URL, -1 if none.
+func getEntryIndexByURL(entries []wallabago.Item, u string) int {
+	hashed := hashURL(u)
+	normalized := normalizeURL(u)
+
+	for i := 0; i < len(entries); i++ {
+		if entries[i].HashedURL == hashed || entries[i].HashedGivenURL == hashed {
+			return i
+		}
+		if normalizeURL(entries[i].URL) == normalized ||
+			(entries[i].GivenURL != "" && normalizeURL(entries[i].GivenURL) == normalized) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Retrieve all tag labels used by the given entries, sorted.
+func getKnownTags(entries []wallabago.Item) []string {
+	seen := map[string]bool{}
+	var tags []string
+	for i := 0; i < len(entries); i++ {
+		for _, t := range entries[i].Tags {
+			if !seen[t.Label] {
+				seen[t.Label] = true
+				tags = append(tags, t.Label)
+			}
+		}
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// Split a comma separated list of tags, ignoring empty ones.
+func parseTags(input string) []string {
+	var tags []string
+	for _, t := range strings.Split(input, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+
+	return tags
+}
+
+// Retrieve the first known tag starting with the last tag being typed.
+func getTagSuggestion(input string, knownTags []string) string {
+	parts := strings.Split(input, ",")
+	current := strings.ToLower(strings.TrimSpace(parts[len(parts)-1]))
+	if current == "" {
+		return ""
+	}
+
+	for _, t := range knownTags {
+		if strings.HasPrefix(strings.ToLower(t), current) && strings.ToLower(t) != current {
+			return t
+		}
+	}
+
+	return ""
+}
+
+// Replace the last tag being typed by the given suggestion.
+func completeTag(input, suggestion string) string {
+	parts := strings.Split(input, ",")
+	parts[len(parts)-1] = suggestion
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	return strings.Join(parts, ", ") + ", "
+}
+
+// Date format used to edit the published date.
+const publishedDateLayout = "2006-01-02"
+
+// Retrieve the editable metadata of an entry.
+func getEntryMetadata(entry *wallabago.Item) walgotEntryMetadata {
+	var tags []string
+	for _, t := range entry.Tags {
+		tags = append(tags, t.Label)
+	}
+	publishedAt := ""
+	if entry.PublishedAt != nil && !entry.PublishedAt.IsZero() {
+		publishedAt = entry.PublishedAt.Format(publishedDateLayout)
+	}
+
+	return walgotEntryMetadata{
+		Title:       entry.Title,
+		Tags:        strings.Join(tags, ", "),
+		Language:    entry.Language,
+		OriginURL:   entry.OriginURL,
+		PublishedAt: publishedAt,
+		Authors:     strings.Join(entry.PublishedBy, ", "),
+	}
+}
+
+// Compare edited metadata with the entry and retrieve the fields to send
+// to wallabag and the IDs of the tags to remove.
+func getEntryMetadataChanges(entry *wallabago.Item, edited walgotEntryMetadata) (map[string]string, []int, error) {
+	current := getEntryMetadata(entry)
+	fields := map[string]string{}
+
+	if title := strings.TrimSpace(edited.Title); title != current.Title {
+		if title == "" {
+			return nil, nil, errors.New("title can't be empty")
+		}
+		fields["title"] = title
+	}
+	if language := strings.TrimSpace(edited.Language); language != current.Language {
+		fields["language"] = language
+	}
+	if originURL := strings.TrimSpace(edited.OriginURL); originURL != current.OriginURL {
+		if originURL != "" && !isValidURL(originURL) {
+			return nil, nil, errors.New("invalid original URL")
+		}
+		fields["origin_url"] = originURL
+	}
+	// Wallabag ignores empty published dates and authors, they can't be cleared:
+	if publishedAt := strings.TrimSpace(edited.PublishedAt); publishedAt != current.PublishedAt {
+		if publishedAt == "" {
+			return nil, nil, errors.New("published date can't be cleared")
+		}
+		t, err := time.Parse(publishedDateLayout, publishedAt)
+		if err != nil {
+			return nil, nil, errors.New("invalid published date, expected YYYY-MM-DD")
+		}
+		fields["published_at"] = strconv.FormatInt(t.Unix(), 10)
+	}
+	if authors := strings.Join(parseTags(edited.Authors), ", "); authors != current.Authors {
+		if authors == "" {
+			return nil, nil, errors.New("authors can't be cleared")
+		}
+		fields["authors"] = strings.Join(parseTags(edited.Authors), ",")
+	}
+
+	// Tags: new ones are sent, missing ones needs to be removed:
+	editedTags := parseTags(edited.Tags)
+	var added []string
+	for _, t := range editedTags {
+		found := false
+		for _, et := range entry.Tags {
+			if et.Label == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			added = append(added, t)
+		}
+	}
+	if len(added) > 0 {
+		fields["tags"] = strings.Join(added, ",")
+	}
+	var removedTagIDs []int
+	for _, et := range entry.Tags {
+		found := false
+		for _, t := range editedTags {
+			if et.Label == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removedTagIDs = append(removedTagIDs, et.ID)
+		}
+	}
+
+	return fields, removedTagIDs, nil
+}
+
+func getContentForViewport(contentHTML string, images []walgotImage) (string, []string) {
 	content, links := getCleanedContentAndLinks(contentHTML)
 	content += "\r\n\r\n\r\n" + generateFootnoteLinks(links)
+	if len(images) > 0 {
+		content += "\r\n" + generateFootnoteImages(images)
+	}
 
-	return content
+	return content, links
 }
 
 func getCleanedContentAndLinks(contentHTML string) (string, []string) {
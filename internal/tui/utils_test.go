@@ -2,7 +2,10 @@ package tui
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Strubbl/wallabago/v7"
 )
@@ -60,3 +63,345 @@ func TestGetSelectedEntryIndex(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeURL(t *testing.T) {
+	var tests = []struct {
+		input    string
+		expected string
+	}{
+		{"https://example.com/article/", "https://example.com/article"},
+		{"HTTPS://Example.COM/article", "https://example.com/article"},
+		{"https://example.com/article?utm_source=rss&utm_medium=feed", "https://example.com/article"},
+		{"https://example.com/article?id=3&fbclid=abc", "https://example.com/article?id=3"},
+		{"https://example.com/article#comments", "https://example.com/article"},
+		{"https://example.com/?b=2&a=1", "https://example.com?a=1&b=2"},
+		{"not a url", "not a url"},
+	}
+
+	for _, test := range tests {
+		result := normalizeURL(test.input)
+		if test.expected != result {
+			t.Errorf("normalizeURL(%v): expected %v, got %v", test.input, test.expected, result)
+		}
+	}
+}
+
+func TestGetEntryIndexByURL(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, URL: "https://example.com/first", HashedURL: hashURL("https://example.com/first")},
+		{ID: 2, URL: "https://example.com/second/", GivenURL: "https://example.com/second?utm_source=rss"},
+		{ID: 3, URL: "https://example.org/third"},
+	}
+	var tests = []struct {
+		inputURL      string
+		expectedIndex int
+	}{
+		{"https://example.com/first", 0},
+		{"https://example.com/second", 1},
+		{"https://example.com/second/?utm_campaign=test", 1},
+		{"https://EXAMPLE.org/third/", 2},
+		{"https://example.org/fourth", -1},
+	}
+
+	for _, test := range tests {
+		result := getEntryIndexByURL(items, test.inputURL)
+		if test.expectedIndex != result {
+			t.Errorf("getEntryIndexByURL(%v): expectedIndex %v, got %v", test.inputURL, test.expectedIndex, result)
+		}
+	}
+}
+
+func TestGetKnownTags(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, Tags: []wallabago.Tag{{Label: "golang"}, {Label: "tui"}}},
+		{ID: 2, Tags: []wallabago.Tag{{Label: "selfhosting"}, {Label: "golang"}}},
+		{ID: 3},
+	}
+	expected := []string{"golang", "selfhosting", "tui"}
+
+	result := getKnownTags(items)
+	if strings.Join(result, ",") != strings.Join(expected, ",") {
+		t.Errorf("getKnownTags(): expected %v, got %v", expected, result)
+	}
+}
+
+func TestGetTagSuggestion(t *testing.T) {
+	knownTags := []string{"golang", "gopher", "selfhosting", "tui"}
+	var tests = []struct {
+		input              string
+		expectedSuggestion string
+		expectedCompletion string
+	}{
+		{"go", "golang", "golang, "},
+		{"tui, sel", "selfhosting", "tui, selfhosting, "},
+		{"GOP", "gopher", "gopher, "},
+		{"golang", "", ""},
+		{"tui, ", "", ""},
+		{"rust", "", ""},
+	}
+
+	for _, test := range tests {
+		result := getTagSuggestion(test.input, knownTags)
+		if test.expectedSuggestion != result {
+			t.Errorf("getTagSuggestion(%v): expected %v, got %v", test.input, test.expectedSuggestion, result)
+		}
+		if result == "" {
+			continue
+		}
+		if completion := completeTag(test.input, result); completion != test.expectedCompletion {
+			t.Errorf("completeTag(%v, %v): expected %v, got %v", test.input, result, test.expectedCompletion, completion)
+		}
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	var tests = []struct {
+		input    string
+		expected []string
+	}{
+		{"golang, tui", []string{"golang", "tui"}},
+		{" golang ,, tui, ", []string{"golang", "tui"}},
+		{"", nil},
+	}
+
+	for _, test := range tests {
+		result := parseTags(test.input)
+		if strings.Join(result, ",") != strings.Join(test.expected, ",") {
+			t.Errorf("parseTags(%v): expected %v, got %v", test.input, test.expected, result)
+		}
+	}
+}
+
+func TestGetEntryMetadataChanges(t *testing.T) {
+	entry := wallabago.Item{
+		ID:          1,
+		Title:       "Some title",
+		Language:    "en",
+		Tags:        []wallabago.Tag{{ID: 4, Label: "golang"}, {ID: 5, Label: "tui"}},
+		PublishedBy: []string{"bacardi55"},
+	}
+	unchanged := getEntryMetadata(&entry)
+
+	var tests = []struct {
+		edited                walgotEntryMetadata
+		expectedFields        map[string]string
+		expectedRemovedTagIDs []int
+		expectedIsErrNil      bool
+	}{
+		{unchanged, map[string]string{}, nil, true},
+		{
+			walgotEntryMetadata{Title: "New title", Tags: "golang, tui", Language: "fr", Authors: "bacardi55"},
+			map[string]string{"title": "New title", "language": "fr"},
+			nil,
+			true,
+		},
+		{
+			walgotEntryMetadata{Title: "Some title", Tags: "golang, wallabag", Language: "en", Authors: "bacardi55"},
+			map[string]string{"tags": "wallabag"},
+			[]int{5},
+			true,
+		},
+		{
+			walgotEntryMetadata{Title: "Some title", Tags: "golang, tui", Language: "en", PublishedAt: "2022-12-03", Authors: "bacardi55"},
+			map[string]string{"published_at": "1670025600"},
+			nil,
+			true,
+		},
+		{walgotEntryMetadata{Title: ""}, nil, nil, false},
+		{walgotEntryMetadata{Title: "Some title", PublishedAt: "03/12/2022"}, nil, nil, false},
+		{walgotEntryMetadata{Title: "Some title", OriginURL: "not a url"}, nil, nil, false},
+		// Wallabag can't clear authors:
+		{walgotEntryMetadata{Title: "Some title", Tags: "golang, tui", Language: "en"}, nil, nil, false},
+	}
+
+	for _, test := range tests {
+		fields, removedTagIDs, e := getEntryMetadataChanges(&entry, test.edited)
+		isErrNil := (e == nil)
+		if isErrNil != test.expectedIsErrNil {
+			t.Errorf("getEntryMetadataChanges(%v): expectedIsErrNil %v, got %v", test.edited, test.expectedIsErrNil, isErrNil)
+		}
+		if !isErrNil {
+			continue
+		}
+		if fmt.Sprint(fields) != fmt.Sprint(test.expectedFields) {
+			t.Errorf("getEntryMetadataChanges(%v): expectedFields %v, got %v", test.edited, test.expectedFields, fields)
+		}
+		if fmt.Sprint(removedTagIDs) != fmt.Sprint(test.expectedRemovedTagIDs) {
+			t.Errorf("getEntryMetadataChanges(%v): expectedRemovedTagIDs %v, got %v", test.edited, test.expectedRemovedTagIDs, removedTagIDs)
+		}
+	}
+
+	// Nor published dates:
+	entry.PublishedAt = &wallabago.WallabagTime{Time: time.Date(2022, time.December, 3, 0, 0, 0, 0, time.UTC)}
+	cleared := getEntryMetadata(&entry)
+	cleared.PublishedAt = ""
+	if _, _, e := getEntryMetadataChanges(&entry, cleared); e == nil {
+		t.Errorf("getEntryMetadataChanges(%v): expected an error clearing the published date", cleared)
+	}
+}
+
+func TestGetFailedEntryIDs(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, Content: "<p>Some content</p>"},
+		{ID: 2, Content: "<p>wallabag can't retrieve contents for this article. Please troubleshoot this issue.</p>"},
+		{ID: 3, Content: " "},
+	}
+	expected := []int{2, 3}
+
+	result := getFailedEntryIDs(items)
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("getFailedEntryIDs(): expected %v, got %v", expected, result)
+	}
+}
+
+func TestGetDisplayedReadingTime(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, ReadingTime: 3, IsArchived: 0},
+		{ID: 2, ReadingTime: 12, IsArchived: 1},
+		{ID: 3, ReadingTime: 45, IsArchived: 0},
+		{ID: 4, ReadingTime: 5, IsArchived: 0},
+		// Unknown reading time:
+		{ID: 5, ReadingTime: 0, IsArchived: 0},
+	}
+	var tests = []struct {
+		filters             walgotTableFilters
+		expectedNb          int
+		expectedReadingTime int
+	}{
+		{walgotTableFilters{}, 5, 65},
+		{walgotTableFilters{Unread: true}, 4, 53},
+		{walgotTableFilters{Short: true}, 1, 3},
+		{walgotTableFilters{Long: true}, 1, 45},
+		{walgotTableFilters{Archived: true, Long: true}, 0, 0},
+	}
+
+	for _, test := range tests {
+		nb, readingTime := getDisplayedReadingTime(items, test.filters)
+		if nb != test.expectedNb || readingTime != test.expectedReadingTime {
+			t.Errorf("getDisplayedReadingTime(%v): expected %v / %v, got %v / %v", test.filters, test.expectedNb, test.expectedReadingTime, nb, readingTime)
+		}
+	}
+}
+
+func TestGetDisplayedEntryID(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, IsArchived: 0},
+		{ID: 2, IsArchived: 1},
+		{ID: 3, IsArchived: 0},
+	}
+	var tests = []struct {
+		filters  walgotTableFilters
+		position int
+		expected int
+	}{
+		{walgotTableFilters{}, 0, 1},
+		{walgotTableFilters{}, 1, 2},
+		{walgotTableFilters{Unread: true}, 1, 3},
+		{walgotTableFilters{Unread: true}, 2, 0},
+		{walgotTableFilters{Starred: true}, 0, 0},
+	}
+
+	for _, test := range tests {
+		if id := getDisplayedEntryID(items, test.filters, test.position); id != test.expected {
+			t.Errorf("getDisplayedEntryID(%v, %v): expected %v, got %v", test.filters, test.position, test.expected, id)
+		}
+	}
+}
+
+func TestGetDisplayedEntryPosition(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, IsArchived: 0},
+		{ID: 2, IsArchived: 1},
+		{ID: 3, IsArchived: 0},
+	}
+	var tests = []struct {
+		filters  walgotTableFilters
+		id       int
+		expected int
+	}{
+		{walgotTableFilters{}, 1, 0},
+		{walgotTableFilters{}, 3, 2},
+		{walgotTableFilters{Unread: true}, 3, 1},
+		{walgotTableFilters{Unread: true}, 2, -1},
+		{walgotTableFilters{}, 4, -1},
+	}
+
+	for _, test := range tests {
+		if position := getDisplayedEntryPosition(items, test.filters, test.id); position != test.expected {
+			t.Errorf("getDisplayedEntryPosition(%v, %v): expected %v, got %v", test.filters, test.id, test.expected, position)
+		}
+	}
+}
+
+func TestGetAdjacentEntryID(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, IsArchived: 0},
+		{ID: 2, IsArchived: 1},
+		{ID: 3, IsArchived: 0},
+	}
+	var tests = []struct {
+		filters  walgotTableFilters
+		id       int
+		backward bool
+		expected int
+	}{
+		{walgotTableFilters{}, 1, false, 2},
+		{walgotTableFilters{}, 2, true, 1},
+		{walgotTableFilters{Unread: true}, 1, false, 3},
+		{walgotTableFilters{Unread: true}, 3, true, 1},
+		// Entry not displayed anymore:
+		{walgotTableFilters{Unread: true}, 2, false, 3},
+		{walgotTableFilters{}, 3, false, 0},
+		{walgotTableFilters{}, 1, true, 0},
+		{walgotTableFilters{}, 4, false, 0},
+	}
+
+	for _, test := range tests {
+		if id := getAdjacentEntryID(items, test.filters, test.id, test.backward); id != test.expected {
+			t.Errorf("getAdjacentEntryID(%v, %v, %v): expected %v, got %v", test.filters, test.id, test.backward, test.expected, id)
+		}
+	}
+}
+
+func TestFormatReadingTime(t *testing.T) {
+	var tests = []struct {
+		input    int
+		expected string
+	}{
+		{0, "0 min"},
+		{25, "25 min"},
+		{60, "1h00"},
+		{125, "2h05"},
+	}
+
+	for _, test := range tests {
+		if output := formatReadingTime(test.input); output != test.expected {
+			t.Errorf("formatReadingTime(%v): expected %v, got %v", test.input, test.expected, output)
+		}
+	}
+}
+
+func TestGetDomains(t *testing.T) {
+	var items = []wallabago.Item{
+		{ID: 1, DomainName: "b.org", IsArchived: 0},
+		{ID: 2, DomainName: "a.org", IsArchived: 1},
+		{ID: 3, DomainName: "c.org", IsArchived: 0},
+		{ID: 4, DomainName: "c.org", IsArchived: 1},
+		{ID: 5, DomainName: ""},
+	}
+	expected := []walgotDomain{
+		{Name: "c.org", NbUnread: 1, NbTotal: 2},
+		{Name: "a.org", NbUnread: 0, NbTotal: 1},
+		{Name: "b.org", NbUnread: 1, NbTotal: 1},
+	}
+
+	result := getDomains(items)
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("getDomains(): expected %v, got %v", expected, result)
+	}
+
+	nb, _ := getDisplayedReadingTime(items, walgotTableFilters{Domain: "c.org", Unread: true})
+	if nb != 1 {
+		t.Errorf("getDisplayedReadingTime(Domain): expected 1, got %v", nb)
+	}
+}